@@ -0,0 +1,97 @@
+package matrix
+
+/*
+Return the shape two operands broadcast to. Each dimension must either match or be 1 in
+one of the operands, in which case that operand is repeated along the dimension.
+*/
+func broadcastShape[T Element](m, a *Matrix[T]) (uint, uint, error) {
+	rows, ok := broadcastDimension(m.rows, a.rows)
+	if !ok {
+		return 0, 0, ErrBroadcastShapes(m.rows, m.columns, a.rows, a.columns)
+	}
+
+	columns, ok := broadcastDimension(m.columns, a.columns)
+	if !ok {
+		return 0, 0, ErrBroadcastShapes(m.rows, m.columns, a.rows, a.columns)
+	}
+
+	return rows, columns, nil
+}
+
+func broadcastDimension(x, y uint) (uint, bool) {
+	switch {
+	case x == y:
+		return x, true
+	case x == 1:
+		return y, true
+	case y == 1:
+		return x, true
+	}
+	return 0, false
+}
+
+// Read the element of a broadcast operand, a dimension of 1 always reads index 0
+func broadcastRead[T Element](m *Matrix[T], i, j uint) T {
+	if m.rows == 1 {
+		i = 0
+	}
+	if m.columns == 1 {
+		j = 0
+	}
+	return m.reader.Read(i, j)
+}
+
+/*
+Combine two matrixes elementwise with fn using NumPy style broadcasting. A 1xN row vector
+is applied to every row, an Nx1 column vector to every column and a 1x1 matrix to every
+element, on either side. Returns an error wrapping ErrIncompatibleShapes that reports
+both shapes when they cannot be broadcast together.
+*/
+func (m Matrix[T]) ZipWithBroadcast(a *Matrix[T], fn func(x, y T) T) (*Matrix[T], error) {
+	rows, columns, err := broadcastShape(&m, a)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := NewEmptyMatrix[T](rows, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := uint(0); i < rows; i++ {
+		for j := uint(0); j < columns; j++ {
+			result.writer.Write(i, j, fn(broadcastRead(&m, i, j), broadcastRead(a, i, j)))
+		}
+	}
+
+	return result, nil
+}
+
+/*
+Add two matrixes using broadcasting, for example adding a 1xN bias row vector to every
+row of an MxN matrix. See ZipWithBroadcast for the rules.
+*/
+func (m Matrix[T]) AddBroadcast(a *Matrix[T]) (*Matrix[T], error) {
+	return m.ZipWithBroadcast(a, func(x, y T) T {
+		return x + y
+	})
+}
+
+/*
+Subtract two matrixes using broadcasting, see ZipWithBroadcast for the rules.
+*/
+func (m Matrix[T]) SubtractBroadcast(a *Matrix[T]) (*Matrix[T], error) {
+	return m.ZipWithBroadcast(a, func(x, y T) T {
+		return x - y
+	})
+}
+
+/*
+Multiply two matrixes elementwise using broadcasting, for example scaling each row of a
+matrix by the matching element of an Mx1 column vector. See ZipWithBroadcast for the rules.
+*/
+func (m Matrix[T]) MultiplyBroadcast(a *Matrix[T]) (*Matrix[T], error) {
+	return m.ZipWithBroadcast(a, func(x, y T) T {
+		return x * y
+	})
+}
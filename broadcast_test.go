@@ -0,0 +1,109 @@
+package matrix
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestAddBroadcast(t *testing.T) {
+	batch, _ := NewMatrixFromSlice([][]int{
+		{1, 2, 3},
+		{4, 5, 6},
+	})
+
+	t.Run("it adds a row vector to every row", func(t *testing.T) {
+		bias, _ := NewMatrixFromSlice([][]int{{10, 20, 30}})
+
+		got, err := batch.AddBroadcast(bias)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := NewMatrixFromSlice([][]int{
+			{11, 22, 33},
+			{14, 25, 36},
+		})
+		matrixesAreEquivalent(t, got, want)
+	})
+
+	t.Run("it adds a column vector to every column", func(t *testing.T) {
+		column, _ := NewMatrixFromSlice([][]int{{100}, {200}})
+
+		got, err := column.AddBroadcast(batch)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := NewMatrixFromSlice([][]int{
+			{101, 102, 103},
+			{204, 205, 206},
+		})
+		matrixesAreEquivalent(t, got, want)
+	})
+
+	t.Run("it broadcasts a row vector against a column vector", func(t *testing.T) {
+		row, _ := NewMatrixFromSlice([][]int{{1, 2, 3}})
+		column, _ := NewMatrixFromSlice([][]int{{10}, {20}})
+
+		got, err := row.AddBroadcast(column)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := NewMatrixFromSlice([][]int{
+			{11, 12, 13},
+			{21, 22, 23},
+		})
+		matrixesAreEquivalent(t, got, want)
+	})
+
+	t.Run("it adds matrixes of the same dimensions", func(t *testing.T) {
+		got, _ := batch.AddBroadcast(batch)
+		want, _ := batch.Add(batch)
+		matrixesAreEqual(t, got, want)
+	})
+
+	t.Run("it returns an error reporting both shapes", func(t *testing.T) {
+		other, _ := NewEmptyMatrix[int](3, 3)
+
+		_, err := batch.AddBroadcast(other)
+		if !errors.Is(err, ErrIncompatibleShapes) {
+			t.Fatalf("expected ErrIncompatibleShapes, got %v", err)
+		}
+		if !strings.Contains(err.Error(), "2x3 and 3x3") {
+			t.Errorf("expected both shapes in %q", err.Error())
+		}
+	})
+}
+
+func TestSubtractAndMultiplyBroadcast(t *testing.T) {
+	m, _ := NewMatrixFromSlice([][]float64{
+		{1, 2},
+		{3, 4},
+	})
+
+	scale, _ := NewMatrixFromSlice([][]float64{{2}, {-1}})
+	got, err := m.MultiplyBroadcast(scale)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want, _ := NewMatrixFromSlice([][]float64{
+		{2, 4},
+		{-3, -4},
+	})
+	matrixesAreEquivalent(t, got, want)
+
+	scalar, _ := NewMatrixFromSlice([][]float64{{1}})
+	got, err = m.SubtractBroadcast(scalar)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want, _ = NewMatrixFromSlice([][]float64{
+		{0, 1},
+		{2, 3},
+	})
+	matrixesAreEquivalent(t, got, want)
+}
@@ -0,0 +1,327 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+)
+
+/*
+Returned by the checked operations when an element overflows or underflows T. Row and
+Column are the position of the offending element in the result. Matches
+ErrArithmeticOverflow with errors.Is.
+*/
+type OverflowError struct {
+	Operation string
+	Row       uint
+	Column    uint
+}
+
+func (e *OverflowError) Error() string {
+	return fmt.Sprintf("arithmetic overflow in %s at position [%d %d]", e.Operation, e.Row, e.Column)
+}
+
+func (e *OverflowError) Unwrap() error {
+	return ErrArithmeticOverflow
+}
+
+/*
+Overflow aware arithmetic for an element type. Integers are checked against the limits
+of T. Floats overflow when finite operands produce an infinite result.
+*/
+type arithmetic[T Element] struct {
+	kind     byte
+	min      T
+	max      T
+	minusOne T
+}
+
+func newArithmetic[T Element]() arithmetic[T] {
+	kind, size := elementKind[T]()
+	a := arithmetic[T]{kind: kind}
+
+	bits := uint(size) * 8
+
+	switch kind {
+	case 'i':
+		max := int64(1)<<(bits-1) - 1
+		min := -max - 1
+		a.max, a.min = T(max), T(min)
+		a.minusOne = a.minusOne - 1
+	case 'u':
+		max := uint64(math.MaxUint64) >> (64 - bits)
+		a.max = T(max)
+	}
+
+	return a
+}
+
+// Whether a float result overflowed to infinity from finite operands
+func (a arithmetic[T]) infinite(x, y, result T) bool {
+	return math.IsInf(float64(result), 0) && !math.IsInf(float64(x), 0) && !math.IsInf(float64(y), 0)
+}
+
+// Add returning false if the result overflows
+func (a arithmetic[T]) add(x, y T) (T, bool) {
+	s := x + y
+
+	switch a.kind {
+	case 'f':
+		return s, !a.infinite(x, y, s)
+	case 'u':
+		return s, s >= x
+	default:
+		return s, !((y > 0 && s < x) || (y < 0 && s > x))
+	}
+}
+
+// Subtract returning false if the result overflows or underflows
+func (a arithmetic[T]) sub(x, y T) (T, bool) {
+	d := x - y
+
+	switch a.kind {
+	case 'f':
+		return d, !a.infinite(x, y, d)
+	case 'u':
+		return d, y <= x
+	default:
+		return d, !((y > 0 && d > x) || (y < 0 && d < x))
+	}
+}
+
+// Multiply returning false if the result overflows
+func (a arithmetic[T]) mul(x, y T) (T, bool) {
+	p := x * y
+
+	switch a.kind {
+	case 'f':
+		return p, !a.infinite(x, y, p)
+	}
+
+	if x == 0 || y == 0 {
+		return p, true
+	}
+
+	// The most negative value divided by -1 does not trap in Go so check it explicitly
+	if a.kind == 'i' && ((x == a.minusOne && y == a.min) || (y == a.minusOne && x == a.min)) {
+		return p, false
+	}
+
+	return p, p/y == x
+}
+
+// Add clamping the result to the limits of T
+func (a arithmetic[T]) addSaturating(x, y T) T {
+	s, ok := a.add(x, y)
+	if ok || a.kind == 'f' {
+		return s
+	}
+	if y > 0 {
+		return a.max
+	}
+	return a.min
+}
+
+// Subtract clamping the result to the limits of T
+func (a arithmetic[T]) subSaturating(x, y T) T {
+	d, ok := a.sub(x, y)
+	if ok || a.kind == 'f' {
+		return d
+	}
+	if a.kind == 'u' || y > 0 {
+		return a.min
+	}
+	return a.max
+}
+
+// Multiply clamping the result to the limits of T
+func (a arithmetic[T]) mulSaturating(x, y T) T {
+	p, ok := a.mul(x, y)
+	if ok || a.kind == 'f' {
+		return p
+	}
+	if (x < 0) != (y < 0) {
+		return a.min
+	}
+	return a.max
+}
+
+/*
+Apply a checked elementwise operation to two matrixes of the same dimensions, stopping
+at the first element that overflows
+*/
+func elementwiseChecked[T Element](m, a *Matrix[T], operation string, fn func(x, y T) (T, bool)) (*Matrix[T], error) {
+	if !AreSameDimensions(m, a) {
+		return nil, ErrMustBeSameDimensions
+	}
+
+	result, err := NewEmptyMatrix[T](m.rows, m.columns)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := uint(0); i < m.rows; i++ {
+		for j := uint(0); j < m.columns; j++ {
+			v, ok := fn(m.reader.Read(i, j), a.reader.Read(i, j))
+			if !ok {
+				return nil, &OverflowError{Operation: operation, Row: i, Column: j}
+			}
+			result.writer.Write(i, j, v)
+		}
+	}
+
+	return result, nil
+}
+
+/*
+Add two matrixes returning an *OverflowError if any element overflows T
+*/
+func (m Matrix[T]) AddChecked(a *Matrix[T]) (*Matrix[T], error) {
+	return elementwiseChecked(&m, a, "add", newArithmetic[T]().add)
+}
+
+/*
+Subtract two matrixes returning an *OverflowError if any element overflows or
+underflows T
+*/
+func (m Matrix[T]) SubtractChecked(a *Matrix[T]) (*Matrix[T], error) {
+	return elementwiseChecked(&m, a, "subtract", newArithmetic[T]().sub)
+}
+
+/*
+Multiply two matrixes elementwise returning an *OverflowError if any element overflows T
+*/
+func (m Matrix[T]) HadamardProductChecked(a *Matrix[T]) (*Matrix[T], error) {
+	return elementwiseChecked(&m, a, "hadamard product", newArithmetic[T]().mul)
+}
+
+/*
+Scalar multiply returning an *OverflowError if any element overflows T
+*/
+func (m Matrix[T]) ScalarMultiplyChecked(c T) (*Matrix[T], error) {
+	arith := newArithmetic[T]()
+
+	result, err := NewEmptyMatrix[T](m.rows, m.columns)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := uint(0); i < m.rows; i++ {
+		for j := uint(0); j < m.columns; j++ {
+			v, ok := arith.mul(c, m.reader.Read(i, j))
+			if !ok {
+				return nil, &OverflowError{Operation: "scalar multiply", Row: i, Column: j}
+			}
+			result.writer.Write(i, j, v)
+		}
+	}
+
+	return result, nil
+}
+
+/*
+Multiply two matrixes checking every product and every partial sum. Returns an
+*OverflowError with the position of the first output element that overflows.
+*/
+func (m Matrix[T]) MultiplyChecked(a *Matrix[T]) (*Matrix[T], error) {
+	if m.columns != a.rows {
+		return nil, ErrMultiplicationColumnRowMismatch
+	}
+
+	arith := newArithmetic[T]()
+
+	result, err := NewEmptyMatrix[T](m.rows, a.columns)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := uint(0); i < m.rows; i++ {
+		for j := uint(0); j < a.columns; j++ {
+			var sum T
+			for k := uint(0); k < m.columns; k++ {
+				product, ok := arith.mul(m.reader.Read(i, k), a.reader.Read(k, j))
+				if ok {
+					sum, ok = arith.add(sum, product)
+				}
+				if !ok {
+					return nil, &OverflowError{Operation: "multiply", Row: i, Column: j}
+				}
+			}
+			result.writer.Write(i, j, sum)
+		}
+	}
+
+	return result, nil
+}
+
+/*
+Return the matrix power of n using checked multiplication. Unlike Power the base is only
+squared when it is still needed, so an overflow is only reported if the result itself
+cannot be represented in T.
+*/
+func (m Matrix[T]) PowerChecked(n uint) (*Matrix[T], error) {
+	if m.rows != m.columns {
+		return nil, ErrMatrixMustBeSquare
+	}
+
+	result, err := NewIdentityMatrix[T](m.rows)
+	if err != nil {
+		return nil, err
+	}
+
+	base, err := m.Clone()
+	if err != nil {
+		return nil, err
+	}
+
+	for n > 0 {
+		if n%2 == 1 {
+			result, err = result.MultiplyChecked(base)
+			if err != nil {
+				return nil, err
+			}
+		}
+		n /= 2
+		if n > 0 {
+			base, err = base.MultiplyChecked(base)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return result, nil
+}
+
+/*
+Add two matrixes clamping each element to the limits of T, for example 200 + 100 is 255
+for uint8. Floats are added normally.
+*/
+func (m Matrix[T]) AddSaturating(a *Matrix[T]) (*Matrix[T], error) {
+	return m.ZipWith(a, newArithmetic[T]().addSaturating)
+}
+
+/*
+Subtract two matrixes clamping each element to the limits of T, for example 10 - 20 is 0
+for uint8. Floats are subtracted normally.
+*/
+func (m Matrix[T]) SubtractSaturating(a *Matrix[T]) (*Matrix[T], error) {
+	return m.ZipWith(a, newArithmetic[T]().subSaturating)
+}
+
+/*
+Multiply two matrixes elementwise clamping each element to the limits of T
+*/
+func (m Matrix[T]) HadamardProductSaturating(a *Matrix[T]) (*Matrix[T], error) {
+	return m.ZipWith(a, newArithmetic[T]().mulSaturating)
+}
+
+/*
+Scalar multiply clamping each element to the limits of T
+*/
+func (m Matrix[T]) ScalarMultiplySaturating(c T) (*Matrix[T], error) {
+	arith := newArithmetic[T]()
+
+	return m.Map(func(v T) T {
+		return arith.mulSaturating(c, v)
+	})
+}
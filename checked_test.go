@@ -0,0 +1,229 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestArithmetic(t *testing.T) {
+	t.Run("it detects every int8 overflow", func(t *testing.T) {
+		arith := newArithmetic[int8]()
+
+		for x := math.MinInt8; x <= math.MaxInt8; x++ {
+			for y := math.MinInt8; y <= math.MaxInt8; y++ {
+				inRange := func(v int) bool { return v >= math.MinInt8 && v <= math.MaxInt8 }
+
+				if _, ok := arith.add(int8(x), int8(y)); ok != inRange(x+y) {
+					t.Fatalf("add %d %d: expected ok %v", x, y, inRange(x+y))
+				}
+				if _, ok := arith.sub(int8(x), int8(y)); ok != inRange(x-y) {
+					t.Fatalf("sub %d %d: expected ok %v", x, y, inRange(x-y))
+				}
+				if _, ok := arith.mul(int8(x), int8(y)); ok != inRange(x*y) {
+					t.Fatalf("mul %d %d: expected ok %v", x, y, inRange(x*y))
+				}
+			}
+		}
+	})
+
+	t.Run("it detects every uint8 overflow", func(t *testing.T) {
+		arith := newArithmetic[uint8]()
+
+		for x := 0; x <= math.MaxUint8; x++ {
+			for y := 0; y <= math.MaxUint8; y++ {
+				inRange := func(v int) bool { return v >= 0 && v <= math.MaxUint8 }
+
+				if _, ok := arith.add(uint8(x), uint8(y)); ok != inRange(x+y) {
+					t.Fatalf("add %d %d: expected ok %v", x, y, inRange(x+y))
+				}
+				if _, ok := arith.sub(uint8(x), uint8(y)); ok != inRange(x-y) {
+					t.Fatalf("sub %d %d: expected ok %v", x, y, inRange(x-y))
+				}
+				if _, ok := arith.mul(uint8(x), uint8(y)); ok != inRange(x*y) {
+					t.Fatalf("mul %d %d: expected ok %v", x, y, inRange(x*y))
+				}
+			}
+		}
+	})
+
+	t.Run("it detects overflow at the limits of 64 bit types", func(t *testing.T) {
+		signed := newArithmetic[int64]()
+		if _, ok := signed.mul(-1, math.MinInt64); ok {
+			t.Error("expected -1 * MinInt64 to overflow")
+		}
+		if _, ok := signed.add(math.MaxInt64, 1); ok {
+			t.Error("expected MaxInt64 + 1 to overflow")
+		}
+
+		unsigned := newArithmetic[uint]()
+		if _, ok := unsigned.mul(1<<32, 1<<32); ok {
+			t.Error("expected 2^32 * 2^32 to overflow")
+		}
+	})
+
+	t.Run("it detects float overflow to infinity", func(t *testing.T) {
+		arith := newArithmetic[float32]()
+		if _, ok := arith.mul(math.MaxFloat32, 2); ok {
+			t.Error("expected MaxFloat32 * 2 to overflow")
+		}
+		if _, ok := arith.add(float32(math.Inf(1)), 1); !ok {
+			t.Error("expected infinite operands not to be reported")
+		}
+	})
+}
+
+func TestAddChecked(t *testing.T) {
+	t.Run("it adds matrixes that do not overflow", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice([][]uint8{{100, 200}})
+		b, _ := NewMatrixFromSlice([][]uint8{{100, 55}})
+
+		got, err := a.AddChecked(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := NewMatrixFromSlice([][]uint8{{200, 255}})
+		matrixesAreEquivalent(t, got, want)
+	})
+
+	t.Run("it returns the position of the overflowing element", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice([][]uint8{{1, 2}, {3, 200}})
+		b, _ := NewMatrixFromSlice([][]uint8{{1, 2}, {3, 100}})
+
+		_, err := a.AddChecked(b)
+		if !errors.Is(err, ErrArithmeticOverflow) {
+			t.Fatalf("expected ErrArithmeticOverflow, got %v", err)
+		}
+
+		var overflow *OverflowError
+		if !errors.As(err, &overflow) || overflow.Row != 1 || overflow.Column != 1 {
+			t.Errorf("expected overflow at [1 1], got %v", err)
+		}
+	})
+}
+
+func TestSubtractChecked(t *testing.T) {
+	t.Run("it reports unsigned underflow", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice([][]uint16{{5, 0}})
+		b, _ := NewMatrixFromSlice([][]uint16{{5, 1}})
+
+		_, err := a.SubtractChecked(b)
+
+		var overflow *OverflowError
+		if !errors.As(err, &overflow) || overflow.Column != 1 {
+			t.Errorf("expected overflow at [0 1], got %v", err)
+		}
+	})
+}
+
+func TestScalarMultiplyChecked(t *testing.T) {
+	t.Run("it reports overflow", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice([][]int8{{1, 64}})
+
+		_, err := m.ScalarMultiplyChecked(2)
+		if !errors.Is(err, ErrArithmeticOverflow) {
+			t.Errorf("expected ErrArithmeticOverflow, got %v", err)
+		}
+	})
+}
+
+func TestMultiplyChecked(t *testing.T) {
+	t.Run("it multiplies matrixes that do not overflow", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice([][]int8{{1, 2}, {3, 4}})
+
+		got, err := a.MultiplyChecked(a)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := a.Multiply(a)
+		matrixesAreEqual(t, got, want)
+	})
+
+	t.Run("it reports an overflowing partial sum", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice([][]int8{{100, 100}})
+		b, _ := NewMatrixFromSlice([][]int8{{1}, {1}})
+
+		_, err := a.MultiplyChecked(b)
+
+		var overflow *OverflowError
+		if !errors.As(err, &overflow) || overflow.Row != 0 || overflow.Column != 0 {
+			t.Errorf("expected overflow at [0 0], got %v", err)
+		}
+	})
+}
+
+func TestPowerChecked(t *testing.T) {
+	t.Run("it reports overflow when squaring a small uint8 matrix", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice([][]uint8{{1, 1}, {1, 1}})
+
+		_, err := m.PowerChecked(9)
+		if !errors.Is(err, ErrArithmeticOverflow) {
+			t.Errorf("expected ErrArithmeticOverflow, got %v", err)
+		}
+	})
+
+	t.Run("it does not square the base more than needed", func(t *testing.T) {
+		// 2^7 = 128 fits in uint8 but the next squaring of the base would not
+		m, _ := NewMatrixFromSlice([][]uint8{{2}})
+
+		got, err := m.PowerChecked(7)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := NewMatrixFromSlice([][]uint8{{128}})
+		matrixesAreEquivalent(t, got, want)
+	})
+}
+
+func TestSaturating(t *testing.T) {
+	t.Run("it clamps uint8 image data", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice([][]uint8{{200, 10, 3}})
+		b, _ := NewMatrixFromSlice([][]uint8{{100, 20, 4}})
+
+		sum, _ := a.AddSaturating(b)
+		want, _ := NewMatrixFromSlice([][]uint8{{255, 30, 7}})
+		matrixesAreEquivalent(t, sum, want)
+
+		difference, _ := a.SubtractSaturating(b)
+		want, _ = NewMatrixFromSlice([][]uint8{{100, 0, 0}})
+		matrixesAreEquivalent(t, difference, want)
+
+		product, _ := a.HadamardProductSaturating(b)
+		want, _ = NewMatrixFromSlice([][]uint8{{255, 200, 12}})
+		matrixesAreEquivalent(t, product, want)
+
+		scaled, _ := a.ScalarMultiplySaturating(2)
+		want, _ = NewMatrixFromSlice([][]uint8{{255, 20, 6}})
+		matrixesAreEquivalent(t, scaled, want)
+	})
+
+	t.Run("it clamps signed values to both limits", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice([][]int8{{100, -100, -100}})
+		b, _ := NewMatrixFromSlice([][]int8{{100, 100, -100}})
+
+		sum, _ := a.AddSaturating(b)
+		want, _ := NewMatrixFromSlice([][]int8{{127, 0, -128}})
+		matrixesAreEquivalent(t, sum, want)
+
+		difference, _ := a.SubtractSaturating(b)
+		want, _ = NewMatrixFromSlice([][]int8{{0, -128, 0}})
+		matrixesAreEquivalent(t, difference, want)
+
+		product, _ := a.HadamardProductSaturating(b)
+		want, _ = NewMatrixFromSlice([][]int8{{127, -128, 127}})
+		matrixesAreEquivalent(t, product, want)
+	})
+
+	t.Run("it returns an error if the matrixes have different dimensions", func(t *testing.T) {
+		a, _ := NewEmptyMatrix[uint8](2, 2)
+		b, _ := NewEmptyMatrix[uint8](1, 2)
+
+		_, err := a.AddSaturating(b)
+		if !errors.Is(err, ErrMustBeSameDimensions) {
+			t.Errorf("expected ErrMustBeSameDimensions, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,232 @@
+package matrix
+
+import "math"
+
+/*
+The Cholesky decomposition of a symmetric positive definite matrix, A = LL^T where L is
+lower triangular with a positive diagonal.
+*/
+type Cholesky[T Float] struct {
+	size uint
+	l    [][]T
+}
+
+/*
+Factor a symmetric positive definite matrix, such as a covariance matrix. Returns
+ErrNotSymmetric if the upper and lower triangles differ by more than rounding error and
+ErrNotPositiveDefinite if the matrix is not positive definite. The factor is computed
+from the lower triangle.
+*/
+func NewCholesky[T Float](m *Matrix[T]) (*Cholesky[T], error) {
+	if m.rows != m.columns {
+		return nil, ErrMatrixMustBeSquare
+	}
+
+	n := m.rows
+
+	if !isSymmetric(n, m.reader.Read) {
+		return nil, ErrNotSymmetric
+	}
+
+	l := make([][]T, n)
+	for i := uint(0); i < n; i++ {
+		l[i] = make([]T, n)
+	}
+
+	for j := uint(0); j < n; j++ {
+		d := m.reader.Read(j, j)
+		for k := uint(0); k < j; k++ {
+			d -= l[j][k] * l[j][k]
+		}
+
+		// Also rejects NaN, which fails every comparison
+		if !(d > 0) {
+			return nil, ErrNotPositiveDefinite
+		}
+
+		l[j][j] = T(math.Sqrt(float64(d)))
+
+		for i := j + 1; i < n; i++ {
+			s := m.reader.Read(i, j)
+			for k := uint(0); k < j; k++ {
+				s -= l[i][k] * l[j][k]
+			}
+			l[i][j] = s / l[j][j]
+		}
+	}
+
+	return &Cholesky[T]{size: n, l: l}, nil
+}
+
+/*
+Report whether every pair of mirrored elements of an n x n matrix read by at agrees to
+within n * eps times the largest magnitude, so a matrix computed as AA^T still passes.
+*/
+func isSymmetric[T Float](n uint, at func(i, j uint) T) bool {
+	var largest T
+	for i := uint(0); i < n; i++ {
+		for j := uint(0); j < n; j++ {
+			largest = max(largest, abs(at(i, j)))
+		}
+	}
+
+	tol := T(n) * machineEpsilon[T]() * largest
+
+	for i := uint(0); i < n; i++ {
+		for j := uint(0); j < i; j++ {
+			if abs(at(i, j)-at(j, i)) > tol {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Return the lower triangular factor
+func (f *Cholesky[T]) L() *Matrix[T] {
+	l, _ := NewEmptyMatrix[T](f.size, f.size)
+
+	for i := uint(0); i < f.size; i++ {
+		for j := uint(0); j <= i; j++ {
+			l.writer.Write(i, j, f.l[i][j])
+		}
+	}
+
+	return l
+}
+
+func (f *Cholesky[T]) Determinant() T {
+	det := T(1)
+	for i := uint(0); i < f.size; i++ {
+		det *= f.l[i][i] * f.l[i][i]
+	}
+	return det
+}
+
+/*
+Solve AX = B for X where A is the decomposed matrix. B may have any number of columns,
+each column is solved as a separate system.
+*/
+func (f *Cholesky[T]) Solve(b *Matrix[T]) (*Matrix[T], error) {
+	if b.rows != f.size {
+		return nil, ErrSolveRowMismatch
+	}
+
+	n := f.size
+
+	x, err := NewEmptyMatrix[T](n, b.columns)
+	if err != nil {
+		return nil, err
+	}
+
+	y := make([]T, n)
+
+	for c := uint(0); c < b.columns; c++ {
+		// Forward substitution, Ly = b
+		for i := uint(0); i < n; i++ {
+			sum := b.reader.Read(i, c)
+			for j := uint(0); j < i; j++ {
+				sum -= f.l[i][j] * y[j]
+			}
+			y[i] = sum / f.l[i][i]
+		}
+
+		// Back substitution, L^T x = y
+		for i := n; i > 0; i-- {
+			r := i - 1
+			sum := y[r]
+			for j := r + 1; j < n; j++ {
+				sum -= f.l[j][r] * y[j]
+			}
+			y[r] = sum / f.l[r][r]
+		}
+
+		for i := uint(0); i < n; i++ {
+			x.writer.Write(i, c, y[i])
+		}
+	}
+
+	return x, nil
+}
+
+/*
+Update the factorization in place to that of A + xx^T in O(n^2) time rather than
+refactoring. x must have one element per row of A.
+*/
+func (f *Cholesky[T]) Update(x []T) error {
+	if uint(len(x)) != f.size {
+		return ErrVectorLength(f.size, uint(len(x)))
+	}
+
+	w := make([]T, len(x))
+	copy(w, x)
+
+	for k := uint(0); k < f.size; k++ {
+		r := T(math.Hypot(float64(f.l[k][k]), float64(w[k])))
+		c := r / f.l[k][k]
+		s := w[k] / f.l[k][k]
+		f.l[k][k] = r
+
+		for i := k + 1; i < f.size; i++ {
+			f.l[i][k] = (f.l[i][k] + s*w[i]) / c
+			w[i] = c*w[i] - s*f.l[i][k]
+		}
+	}
+
+	return nil
+}
+
+/*
+Downdate the factorization in place to that of A - xx^T, for example to remove an
+observation from a covariance matrix. Returns ErrNotPositiveDefinite and leaves the
+factorization unchanged if the result would not be positive definite.
+*/
+func (f *Cholesky[T]) Downdate(x []T) error {
+	if uint(len(x)) != f.size {
+		return ErrVectorLength(f.size, uint(len(x)))
+	}
+
+	w := make([]T, len(x))
+	copy(w, x)
+
+	// Work on a copy so a failure part way through does not corrupt the factor
+	l := make([][]T, f.size)
+	for i := range l {
+		l[i] = make([]T, f.size)
+		copy(l[i], f.l[i])
+	}
+
+	for k := uint(0); k < f.size; k++ {
+		d := l[k][k]*l[k][k] - w[k]*w[k]
+		if !(d > 0) {
+			return ErrNotPositiveDefinite
+		}
+
+		r := T(math.Sqrt(float64(d)))
+		c := r / l[k][k]
+		s := w[k] / l[k][k]
+		l[k][k] = r
+
+		for i := k + 1; i < f.size; i++ {
+			l[i][k] = (l[i][k] - s*w[i]) / c
+			w[i] = c*w[i] - s*l[i][k]
+		}
+	}
+
+	f.l = l
+	return nil
+}
+
+/*
+Solve AX = B for a symmetric positive definite A using its Cholesky factorization. Use
+NewCholesky directly to solve several systems with the same A.
+*/
+func SolveCholesky[T Float](a, b *Matrix[T]) (*Matrix[T], error) {
+	f, err := NewCholesky(a)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.Solve(b)
+}
@@ -0,0 +1,185 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestNewCholesky(t *testing.T) {
+	t.Run("it factors the matrix so that A = LL^T", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice([][]float64{
+			{4, 12, -16},
+			{12, 37, -43},
+			{-16, -43, 98},
+		})
+
+		f, err := NewCholesky(a)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := NewMatrixFromSlice([][]float64{
+			{2, 0, 0},
+			{6, 1, 0},
+			{-8, 5, 3},
+		})
+		matrixesAreClose(t, f.L(), want, 1e-12)
+
+		if math.Abs(f.Determinant()-36) > 1e-9 {
+			t.Errorf("expected a determinant of 36, got %v", f.Determinant())
+		}
+	})
+
+	cases := []struct {
+		name  string
+		input [][]float64
+	}{
+		{name: "indefinite", input: [][]float64{{1, 2}, {2, 1}}},
+		{name: "singular", input: [][]float64{{1, 1}, {1, 1}}},
+		{name: "negative diagonal", input: [][]float64{{-1, 0}, {0, 1}}},
+		{name: "NaN", input: [][]float64{{math.NaN(), 0}, {0, 1}}},
+	}
+
+	for _, test := range cases {
+		t.Run("it returns ErrNotPositiveDefinite for "+test.name, func(t *testing.T) {
+			a, _ := NewMatrixFromSlice(test.input)
+
+			_, err := NewCholesky(a)
+			if !errors.Is(err, ErrNotPositiveDefinite) {
+				t.Errorf("expected ErrNotPositiveDefinite, got %v", err)
+			}
+		})
+	}
+
+	t.Run("it returns ErrNotSymmetric if the triangles differ", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice([][]float64{
+			{4, 100},
+			{2, 3},
+		})
+
+		_, err := NewCholesky(a)
+		if !errors.Is(err, ErrNotSymmetric) {
+			t.Errorf("expected ErrNotSymmetric, got %v", err)
+		}
+	})
+
+	t.Run("it accepts rounding error between the triangles", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice([][]float64{
+			{4, 2 + 1e-15},
+			{2, 3},
+		})
+
+		_, err := NewCholesky(a)
+		if err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("it returns an error if the matrix is not square", func(t *testing.T) {
+		a, _ := NewEmptyMatrix[float32](2, 3)
+
+		_, err := NewCholesky(a)
+		if !errors.Is(err, ErrMatrixMustBeSquare) {
+			t.Errorf("expected ErrMatrixMustBeSquare, got %v", err)
+		}
+	})
+}
+
+func TestSolveCholesky(t *testing.T) {
+	t.Run("it solves a linear system", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice([][]float64{
+			{4, 2, 0},
+			{2, 5, 3},
+			{0, 3, 6},
+		})
+		want, _ := NewMatrixFromSlice([][]float64{{1, -1}, {2, 0}, {3, 1}})
+		b, _ := a.Multiply(want)
+
+		x, err := SolveCholesky(a, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		matrixesAreClose(t, x, want, 1e-12)
+	})
+
+	t.Run("it returns an error if the row counts do not match", func(t *testing.T) {
+		a, _ := NewIdentityMatrix[float64](3)
+		b, _ := NewEmptyMatrix[float64](2, 1)
+
+		_, err := SolveCholesky(a, b)
+		if !errors.Is(err, ErrSolveRowMismatch) {
+			t.Errorf("expected ErrSolveRowMismatch, got %v", err)
+		}
+	})
+}
+
+func TestCholeskyUpdate(t *testing.T) {
+	input := [][]float64{
+		{4, 12, -16},
+		{12, 37, -43},
+		{-16, -43, 98},
+	}
+	x := []float64{1, -2, 0.5}
+
+	// A + xx^T
+	updated := make([][]float64, 3)
+	for i := range updated {
+		updated[i] = make([]float64, 3)
+		for j := range updated[i] {
+			updated[i][j] = input[i][j] + x[i]*x[j]
+		}
+	}
+
+	t.Run("it matches refactoring the updated matrix", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice(input)
+		f, _ := NewCholesky(a)
+
+		err := f.Update(x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		b, _ := NewMatrixFromSlice(updated)
+		want, _ := NewCholesky(b)
+		matrixesAreClose(t, f.L(), want.L(), 1e-12)
+	})
+
+	t.Run("it reverses an update with a downdate", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice(updated)
+		f, _ := NewCholesky(a)
+
+		err := f.Downdate(x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		b, _ := NewMatrixFromSlice(input)
+		want, _ := NewCholesky(b)
+		matrixesAreClose(t, f.L(), want.L(), 1e-12)
+	})
+
+	t.Run("it leaves the factor unchanged when a downdate fails", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice(input)
+		f, _ := NewCholesky(a)
+		before := f.L()
+
+		err := f.Downdate([]float64{1, 10, 0})
+		if !errors.Is(err, ErrNotPositiveDefinite) {
+			t.Errorf("expected ErrNotPositiveDefinite, got %v", err)
+		}
+
+		matrixesAreEqual(t, f.L(), before)
+	})
+
+	t.Run("it returns an error for a vector of the wrong length", func(t *testing.T) {
+		a, _ := NewIdentityMatrix[float64](2)
+		f, _ := NewCholesky(a)
+
+		err := f.Update([]float64{1})
+		if err == nil || err.Error() != ErrVectorLength(2, 1).Error() {
+			t.Errorf("expected %v, got %v", ErrVectorLength(2, 1), err)
+		}
+	})
+}
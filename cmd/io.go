@@ -0,0 +1,70 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/isoment/matrix"
+)
+
+// Read the single matrix operand of a command from a file or stdin
+func readOne[T matrix.Element](env *environment) (*matrix.Matrix[T], error) {
+	switch len(env.args) {
+	case 0:
+		return readMatrixFile[T](env, "-")
+	case 1:
+		return readMatrixFile[T](env, env.args[0])
+	default:
+		return nil, fmt.Errorf("%w: %s takes one file", errUsage, env.command)
+	}
+}
+
+// Read the two matrix operands of a command, at most one of them from stdin
+func readTwo[T matrix.Element](env *environment) (*matrix.Matrix[T], *matrix.Matrix[T], error) {
+	if len(env.args) != 2 {
+		return nil, nil, fmt.Errorf("%w: %s takes two files", errUsage, env.command)
+	}
+
+	if env.args[0] == "-" && env.args[1] == "-" {
+		return nil, nil, fmt.Errorf("%w: only one operand can be read from stdin", errUsage)
+	}
+
+	a, err := readMatrixFile[T](env, env.args[0])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, err := readMatrixFile[T](env, env.args[1])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return a, b, nil
+}
+
+func readMatrixFile[T matrix.Element](env *environment, name string) (*matrix.Matrix[T], error) {
+	if name == "-" {
+		return readMatrix[T](env, env.stdin, "stdin")
+	}
+
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return readMatrix[T](env, f, name)
+}
+
+func readMatrix[T matrix.Element](env *environment, r io.Reader, name string) (*matrix.Matrix[T], error) {
+	m, err := matrix.ReadCSV[T](r, env.csvOptions())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return m, nil
+}
+
+func writeMatrix[T matrix.Element](env *environment, m *matrix.Matrix[T]) error {
+	return m.WriteCSV(env.stdout, env.csvOptions())
+}
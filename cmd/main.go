@@ -1,10 +1,334 @@
 package main
 
 import (
+	"bytes"
+	"errors"
+	"flag"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"unicode/utf8"
+
+	"github.com/isoment/matrix"
+)
+
+// Exit codes, the package's sentinel errors each get their own so scripts can tell them apart
+const (
+	exitOK = iota
+	exitNotFound
+	exitUsage
+	exitError
+	exitDimensions
+	exitMultiplication
+	exitSquare
 )
 
+const usage = `usage: matrix <command> [flags] [files]
+
+Commands:
+  add A B          add two matrixes
+  sub A B          subtract B from A
+  mul A B          multiply A by B
+  hadamard A B     elementwise product of A and B
+  transpose A      transpose A
+  power -n N A     raise the square matrix A to the power N
+  search -v V A    print the row,column of every element equal to V
+  identity -n N    print the N x N identity matrix
+
+Matrixes are read from the named files, or stdin when a file is "-" or omitted.
+Rows are separated by newlines and values by the delimiter, lines starting with #
+are ignored.
+
+Common flags:
+  -type T          element type, one of int, int8, int16, int32, int64, uint, uint8,
+                   uint16, uint32, uint64, float32, float64 (default float64)
+  -o FILE          write the result to FILE instead of stdout
+  -d DELIM         value delimiter for input and output, a single character or
+                   "tab" (default ",")
+`
+
 func main() {
-	fmt.Fprint(os.Stdout, "Test\n")
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// The flags shared by every command
+type options struct {
+	elementType string
+	output      string
+	delimiter   string
+	n           uint
+	value       string
+}
+
+/*
+Run the command line, returning the exit code. Split out from main so tests can supply
+their own arguments and streams.
+*/
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return exitUsage
+	}
+
+	command := args[0]
+
+	if command == "help" || command == "-h" || command == "--help" {
+		fmt.Fprint(stdout, usage)
+		return exitOK
+	}
+
+	var opts options
+	fs := flag.NewFlagSet(command, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() { fmt.Fprint(stderr, usage) }
+	fs.StringVar(&opts.elementType, "type", "float64", "element type")
+	fs.StringVar(&opts.output, "o", "", "output file")
+	fs.StringVar(&opts.delimiter, "d", ",", "value delimiter")
+
+	switch command {
+	case "power", "identity":
+		fs.UintVar(&opts.n, "n", 0, "power or size")
+	case "search":
+		fs.StringVar(&opts.value, "v", "", "value to search for")
+	}
+
+	err := fs.Parse(args[1:])
+	if err != nil {
+		return exitUsage
+	}
+
+	if opts.delimiter == "tab" {
+		opts.delimiter = "\t"
+	}
+	if utf8.RuneCountInString(opts.delimiter) != 1 {
+		fmt.Fprintf(stderr, "matrix: %v: delimiter must be a single character\n", errUsage)
+		return exitUsage
+	}
+
+	// Hold the result back until the command succeeds so the output file may also be an operand
+	var buffer bytes.Buffer
+	out := stdout
+	if opts.output != "" {
+		out = &buffer
+	}
+
+	env := &environment{
+		command: command,
+		args:    fs.Args(),
+		opts:    opts,
+		stdin:   stdin,
+		stdout:  out,
+	}
+
+	err = dispatch(env)
+	if err != nil {
+		fmt.Fprintf(stderr, "matrix: %v\n", err)
+		return exitCode(err)
+	}
+
+	if opts.output != "" {
+		err = replaceFile(opts.output, buffer.Bytes())
+		if err != nil {
+			fmt.Fprintf(stderr, "matrix: %v\n", err)
+			return exitError
+		}
+	}
+
+	return exitOK
+}
+
+/*
+Write data to a temporary file beside name then rename it over name, so a failure part
+way through never leaves name truncated. An existing file keeps its permissions.
+*/
+func replaceFile(name string, data []byte) error {
+	mode := os.FileMode(0o644)
+	if info, err := os.Stat(name); err == nil {
+		mode = info.Mode().Perm()
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(name), "."+filepath.Base(name)+".*")
+	if err != nil {
+		return err
+	}
+
+	err = f.Chmod(mode)
+	if err == nil {
+		_, err = f.Write(data)
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), name)
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+
+	return nil
+}
+
+// Map an error to an exit code
+func exitCode(err error) int {
+	switch {
+	case errors.Is(err, errUsage):
+		return exitUsage
+	case errors.Is(err, errNotFound):
+		return exitNotFound
+	case errors.Is(err, matrix.ErrMustBeSameDimensions):
+		return exitDimensions
+	case errors.Is(err, matrix.ErrMultiplicationColumnRowMismatch):
+		return exitMultiplication
+	case errors.Is(err, matrix.ErrMatrixMustBeSquare):
+		return exitSquare
+	default:
+		return exitError
+	}
+}
+
+var (
+	errUsage    = errors.New("invalid usage, run matrix help")
+	errNotFound = errors.New("value not found")
+)
+
+// Everything a command needs to run
+type environment struct {
+	command string
+	args    []string
+	opts    options
+	stdin   io.Reader
+	stdout  io.Writer
+}
+
+func (env *environment) csvOptions() matrix.CSVOptions {
+	delimiter, _ := utf8.DecodeRuneInString(env.opts.delimiter)
+	return matrix.CSVOptions{Delimiter: delimiter, Comment: '#'}
+}
+
+// Pick the element type and run the command with it
+func dispatch(env *environment) error {
+	switch env.opts.elementType {
+	case "int":
+		return execute[int](env)
+	case "int8":
+		return execute[int8](env)
+	case "int16":
+		return execute[int16](env)
+	case "int32":
+		return execute[int32](env)
+	case "int64":
+		return execute[int64](env)
+	case "uint":
+		return execute[uint](env)
+	case "uint8":
+		return execute[uint8](env)
+	case "uint16":
+		return execute[uint16](env)
+	case "uint32":
+		return execute[uint32](env)
+	case "uint64":
+		return execute[uint64](env)
+	case "float32":
+		return execute[float32](env)
+	case "float64":
+		return execute[float64](env)
+	default:
+		return fmt.Errorf("%w: unknown element type %q", errUsage, env.opts.elementType)
+	}
+}
+
+func execute[T matrix.Element](env *environment) error {
+	switch env.command {
+	case "add", "sub", "mul", "hadamard":
+		a, b, err := readTwo[T](env)
+		if err != nil {
+			return err
+		}
+
+		var result *matrix.Matrix[T]
+		switch env.command {
+		case "add":
+			result, err = a.Add(b)
+		case "sub":
+			result, err = a.Subtract(b)
+		case "mul":
+			result, err = a.Multiply(b)
+		case "hadamard":
+			result, err = a.HadamardProduct(b)
+		}
+		if err != nil {
+			return err
+		}
+
+		return writeMatrix(env, result)
+
+	case "transpose":
+		a, err := readOne[T](env)
+		if err != nil {
+			return err
+		}
+
+		result, err := a.Transpose()
+		if err != nil {
+			return err
+		}
+
+		return writeMatrix(env, result)
+
+	case "power":
+		a, err := readOne[T](env)
+		if err != nil {
+			return err
+		}
+
+		result, err := a.Power(env.opts.n)
+		if err != nil {
+			return err
+		}
+
+		return writeMatrix(env, result)
+
+	case "search":
+		if env.opts.value == "" {
+			return fmt.Errorf("%w: search requires -v", errUsage)
+		}
+
+		v, err := matrix.ParseElement[T](env.opts.value)
+		if err != nil {
+			return err
+		}
+
+		a, err := readOne[T](env)
+		if err != nil {
+			return err
+		}
+
+		locations, found := a.Search(v)
+		if !found {
+			return errNotFound
+		}
+
+		for _, l := range locations {
+			fmt.Fprintf(env.stdout, "%d,%d\n", l.Row(), l.Column())
+		}
+		return nil
+
+	case "identity":
+		if len(env.args) != 0 {
+			return fmt.Errorf("%w: identity takes no files", errUsage)
+		}
+
+		result, err := matrix.NewIdentityMatrix[T](env.opts.n)
+		if err != nil {
+			return err
+		}
+
+		return writeMatrix(env, result)
+
+	default:
+		return fmt.Errorf("%w: unknown command %q", errUsage, env.command)
+	}
 }
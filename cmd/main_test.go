@@ -0,0 +1,120 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeFile(t *testing.T, name, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestRun(t *testing.T) {
+	a := writeFile(t, "a.csv", "1,2\n3,4\n")
+	b := writeFile(t, "b.csv", "# comment\n5,6\n7,8\n")
+	wide := writeFile(t, "wide.csv", "1,2,3\n")
+
+	cases := []struct {
+		name  string
+		args  []string
+		stdin string
+		want  string
+		code  int
+	}{
+		{name: "add", args: []string{"add", "-type", "int", a, b}, want: "6,8\n10,12\n"},
+		{name: "sub", args: []string{"sub", "-type", "int", b, a}, want: "4,4\n4,4\n"},
+		{name: "mul", args: []string{"mul", "-type", "int", a, b}, want: "19,22\n43,50\n"},
+		{name: "hadamard", args: []string{"hadamard", "-type", "int", a, b}, want: "5,12\n21,32\n"},
+		{name: "transpose from stdin", args: []string{"transpose"}, stdin: "1,2,3\n", want: "1\n2\n3\n"},
+		{name: "power", args: []string{"power", "-type", "int", "-n", "3", a}, want: "37,54\n81,118\n"},
+		{name: "search", args: []string{"search", "-type", "int", "-v", "4", a}, want: "1,1\n"},
+		{name: "search with two operands", args: []string{"search", "-v", "2", "-", a}, stdin: "2,0\n0,2\n", code: exitUsage},
+		{name: "search stdin", args: []string{"search", "-type", "int", "-v", "2"}, stdin: "2,0\n0,2\n", want: "0,0\n1,1\n"},
+		{name: "tab delimited", args: []string{"transpose", "-type", "int", "-d", "tab"}, stdin: "1\t2\n", want: "1\n2\n"},
+		{name: "bad delimiter", args: []string{"transpose", "-d", "ab"}, stdin: "1\n", code: exitUsage},
+		{name: "search not found", args: []string{"search", "-v", "9", a}, code: exitNotFound},
+		{name: "identity", args: []string{"identity", "-type", "uint8", "-n", "2"}, want: "1,0\n0,1\n"},
+		{name: "float", args: []string{"add", a, a}, want: "2,4\n6,8\n"},
+		{name: "dimension mismatch", args: []string{"add", a, wide}, code: exitDimensions},
+		{name: "multiplication mismatch", args: []string{"mul", wide, a}, code: exitMultiplication},
+		{name: "not square", args: []string{"power", "-n", "2", wide}, code: exitSquare},
+		{name: "unknown type", args: []string{"add", "-type", "complex", a, b}, code: exitUsage},
+		{name: "unknown command", args: []string{"invert", a}, code: exitUsage},
+		{name: "overflow", args: []string{"transpose", "-type", "int8"}, stdin: "300\n", code: exitError},
+		{name: "missing file", args: []string{"transpose", "missing.csv"}, code: exitError},
+		{name: "no arguments", args: []string{}, code: exitUsage},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+
+			code := run(test.args, strings.NewReader(test.stdin), &stdout, &stderr)
+			if code != test.code {
+				t.Errorf("expected exit code %d, got %d: %s", test.code, code, stderr.String())
+			}
+
+			if stdout.String() != test.want {
+				t.Errorf("expected output %q, got %q", test.want, stdout.String())
+			}
+		})
+	}
+
+	t.Run("it writes the result to a file", func(t *testing.T) {
+		output := filepath.Join(t.TempDir(), "out.csv")
+		var stdout, stderr bytes.Buffer
+
+		code := run([]string{"transpose", "-o", output, a}, nil, &stdout, &stderr)
+		if code != exitOK {
+			t.Fatalf("expected exit code 0, got %d: %s", code, stderr.String())
+		}
+
+		got, _ := os.ReadFile(output)
+		if string(got) != "1,3\n2,4\n" {
+			t.Errorf("expected file contents %q, got %q", "1,3\n2,4\n", got)
+		}
+	})
+
+	t.Run("it can write the result over one of its operands", func(t *testing.T) {
+		output := writeFile(t, "out.csv", "1,2\n3,4\n")
+		var stdout, stderr bytes.Buffer
+
+		code := run([]string{"add", "-type", "int", "-o", output, output, b}, nil, &stdout, &stderr)
+		if code != exitOK {
+			t.Fatalf("expected exit code 0, got %d: %s", code, stderr.String())
+		}
+
+		got, _ := os.ReadFile(output)
+		if string(got) != "6,8\n10,12\n" {
+			t.Errorf("expected file contents %q, got %q", "6,8\n10,12\n", got)
+		}
+	})
+
+	t.Run("it leaves the output file alone when the command fails", func(t *testing.T) {
+		output := writeFile(t, "out.csv", "keep\n")
+		var stdout, stderr bytes.Buffer
+
+		code := run([]string{"add", "-o", output, a, wide}, nil, &stdout, &stderr)
+		if code != exitDimensions {
+			t.Fatalf("expected exit code %d, got %d: %s", exitDimensions, code, stderr.String())
+		}
+
+		got, _ := os.ReadFile(output)
+		if string(got) != "keep\n" {
+			t.Errorf("expected file contents %q, got %q", "keep\n", got)
+		}
+
+		entries, _ := os.ReadDir(filepath.Dir(output))
+		if len(entries) != 1 {
+			t.Errorf("expected no temporary files to be left behind, got %d entries", len(entries))
+		}
+	})
+}
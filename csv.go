@@ -0,0 +1,165 @@
+package matrix
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+/*
+Options for reading and writing delimited text. A zero Delimiter means a comma, use '\t'
+for TSV. Comment lines are skipped when reading if Comment is set. SkipHeader discards
+the first record when reading and Header is written as the first record when writing.
+*/
+type CSVOptions struct {
+	Delimiter  rune
+	Comment    rune
+	SkipHeader bool
+	Header     []string
+}
+
+func (o CSVOptions) delimiter() rune {
+	if o.Delimiter == 0 {
+		return ','
+	}
+	return o.Delimiter
+}
+
+/*
+Read a matrix from delimited text, one row per record. Every value is parsed as T and
+values that do not fit in T are reported as overflows. Errors cite the line and column
+of the offending value.
+*/
+func ReadCSV[T Element](r io.Reader, opts CSVOptions) (*Matrix[T], error) {
+	reader := csv.NewReader(r)
+	reader.Comma = opts.delimiter()
+	reader.Comment = opts.Comment
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	reader.ReuseRecord = true
+
+	var data []T
+	var columns uint
+	var rows uint
+	header := opts.SkipHeader
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if header {
+			header = false
+			continue
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		if rows == 0 {
+			columns = uint(len(record))
+		} else if uint(len(record)) != columns {
+			return nil, ErrCSVColumnCountMismatch(line, columns, uint(len(record)))
+		}
+
+		for j, field := range record {
+			field = strings.TrimSpace(field)
+
+			v, err := ParseElement[T](field)
+			if errors.Is(err, strconv.ErrRange) {
+				return nil, ErrCSVOverflow(line, j+1, field, reflect.TypeOf(v).String())
+			}
+			if err != nil {
+				return nil, ErrCSVValue(line, j+1, field)
+			}
+
+			data = append(data, v)
+		}
+		rows++
+	}
+
+	if rows == 0 || columns == 0 {
+		return nil, ErrRowColumSize
+	}
+
+	store, err := NewDenseStoreFromSlice(data, rows, columns, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewMatrix[T](store)
+}
+
+/*
+Write the matrix as delimited text, one record per row. Floats are written in the
+shortest form that reads back to the same value.
+*/
+func (m *Matrix[T]) WriteCSV(w io.Writer, opts CSVOptions) error {
+	writer := csv.NewWriter(w)
+	writer.Comma = opts.delimiter()
+
+	if opts.Header != nil {
+		err := writer.Write(opts.Header)
+		if err != nil {
+			return err
+		}
+	}
+
+	record := make([]string, m.columns)
+
+	for i := uint(0); i < m.rows; i++ {
+		for j := uint(0); j < m.columns; j++ {
+			record[j] = formatElement(m.reader.Read(i, j))
+		}
+
+		err := writer.Write(record)
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+/*
+Parse a value as T. Integers that do not fit in T and floats outside the range of T
+return an error wrapping strconv.ErrRange. Used by ReadCSV and ReadMatrixMarket, exported
+so callers parsing single values apply the same rules.
+*/
+func ParseElement[T Element](s string) (T, error) {
+	var v T
+	kind := reflect.TypeOf(v)
+
+	switch kind.Kind() {
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, kind.Bits())
+		return T(f), err
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, kind.Bits())
+		return T(i), err
+	default:
+		u, err := strconv.ParseUint(s, 10, kind.Bits())
+		return T(u), err
+	}
+}
+
+// Format a value in the shortest form that parses back to the same value
+func formatElement[T Element](v T) string {
+	kind := reflect.TypeOf(v)
+
+	switch kind.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(float64(v), 'g', -1, kind.Bits())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(int64(v), 10)
+	default:
+		return strconv.FormatUint(uint64(v), 10)
+	}
+}
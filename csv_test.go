@@ -0,0 +1,148 @@
+package matrix
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestReadCSV(t *testing.T) {
+	t.Run("it reads a matrix", func(t *testing.T) {
+		input := "1,2,3\n4, 5 ,6\n"
+
+		got, err := ReadCSV[int](strings.NewReader(input), CSVOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := NewMatrixFromSlice([][]int{
+			{1, 2, 3},
+			{4, 5, 6},
+		})
+
+		matrixesAreEquivalent(t, got, want)
+	})
+
+	t.Run("it reads tab separated values with a header and comments", func(t *testing.T) {
+		input := "a\tb\n# a comment\n1.5\t-2\n3e2\t0.25\n"
+
+		got, err := ReadCSV[float64](strings.NewReader(input), CSVOptions{
+			Delimiter:  '\t',
+			Comment:    '#',
+			SkipHeader: true,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := NewMatrixFromSlice([][]float64{
+			{1.5, -2},
+			{300, 0.25},
+		})
+
+		matrixesAreEquivalent(t, got, want)
+	})
+
+	t.Run("it returns an error citing the line and column of an invalid value", func(t *testing.T) {
+		input := "1,2\n3,x\n"
+
+		_, err := ReadCSV[int](strings.NewReader(input), CSVOptions{})
+		if err == nil || err.Error() != ErrCSVValue(2, 2, "x").Error() {
+			t.Errorf("expected %v, got %v", ErrCSVValue(2, 2, "x"), err)
+		}
+	})
+
+	t.Run("it returns an error for values that overflow narrow types", func(t *testing.T) {
+		cases := []struct {
+			name string
+			read func() error
+			want error
+		}{
+			{
+				name: "uint8",
+				read: func() error { _, err := ReadCSV[uint8](strings.NewReader("255,256\n"), CSVOptions{}); return err },
+				want: ErrCSVOverflow(1, 2, "256", "uint8"),
+			},
+			{
+				name: "int8",
+				read: func() error { _, err := ReadCSV[int8](strings.NewReader("1\n-129\n"), CSVOptions{}); return err },
+				want: ErrCSVOverflow(2, 1, "-129", "int8"),
+			},
+			{
+				name: "float32",
+				read: func() error { _, err := ReadCSV[float32](strings.NewReader("1e39\n"), CSVOptions{}); return err },
+				want: ErrCSVOverflow(1, 1, "1e39", "float32"),
+			},
+		}
+
+		for _, test := range cases {
+			t.Run(test.name, func(t *testing.T) {
+				err := test.read()
+				if err == nil || err.Error() != test.want.Error() {
+					t.Errorf("expected %v, got %v", test.want, err)
+				}
+			})
+		}
+	})
+
+	t.Run("it rejects negative values for unsigned types", func(t *testing.T) {
+		_, err := ReadCSV[uint](strings.NewReader("-1\n"), CSVOptions{})
+		if err == nil {
+			t.Error("expected error but got none")
+		}
+	})
+
+	t.Run("it returns an error for a column count mismatch", func(t *testing.T) {
+		input := "1,2\n3\n"
+
+		_, err := ReadCSV[int](strings.NewReader(input), CSVOptions{})
+		if err == nil || err.Error() != ErrCSVColumnCountMismatch(2, 2, 1).Error() {
+			t.Errorf("expected %v, got %v", ErrCSVColumnCountMismatch(2, 2, 1), err)
+		}
+	})
+
+	t.Run("it returns an error for empty input", func(t *testing.T) {
+		_, err := ReadCSV[int](strings.NewReader("h\n"), CSVOptions{SkipHeader: true})
+		if !errors.Is(err, ErrRowColumSize) {
+			t.Errorf("expected ErrRowColumSize, got %v", err)
+		}
+	})
+}
+
+func TestWriteCSV(t *testing.T) {
+	t.Run("it writes a matrix with a header", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice([][]float32{
+			{0.1, 2},
+			{-3.5, 1e20},
+		})
+
+		var buf bytes.Buffer
+		err := m.WriteCSV(&buf, CSVOptions{Delimiter: '\t', Header: []string{"x", "y"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := "x\ty\n0.1\t2\n-3.5\t1e+20\n"
+		if buf.String() != want {
+			t.Errorf("expected %q, got %q", want, buf.String())
+		}
+	})
+
+	t.Run("it round trips through ReadCSV", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice([][]uint64{
+			{18446744073709551615, 0},
+			{7, 42},
+		})
+
+		var buf bytes.Buffer
+		m.WriteCSV(&buf, CSVOptions{})
+
+		got, err := ReadCSV[uint64](&buf, CSVOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		matrixesAreEquivalent(t, got, m)
+	})
+}
@@ -0,0 +1,138 @@
+package matrix
+
+import (
+	"math/bits"
+	"unsafe"
+)
+
+/*
+A dense data store backed by a single slice in row major order. Row i starts at offset
+i*stride, the stride is at least the column count and is larger when the store describes
+a block inside a wider slice.
+*/
+type DenseStore[T Element] struct {
+	rows    uint
+	columns uint
+	stride  uint
+	data    []T
+}
+
+/*
+Create a zero filled dense store, the backing slice is allocated once
+*/
+func NewDenseStore[T Element](rows, columns uint) (*DenseStore[T], error) {
+	if rows == 0 || columns == 0 {
+		return nil, ErrRowColumSize
+	}
+
+	var zero T
+	size, ok := checkedProduct(rows, columns)
+	if _, fits := checkedProduct(size, uint(unsafe.Sizeof(zero))); !ok || !fits {
+		return nil, ErrMatrixTooLarge
+	}
+
+	return &DenseStore[T]{
+		rows:    rows,
+		columns: columns,
+		stride:  columns,
+		data:    make([]T, size),
+	}, nil
+}
+
+// Multiply two sizes, the boolean is false when the product overflows uint
+func checkedProduct(a, b uint) (uint, bool) {
+	hi, lo := bits.Mul(a, b)
+	return lo, hi == 0
+}
+
+// Add two sizes, the boolean is false when the sum overflows uint
+func checkedSum(a, b uint) (uint, bool) {
+	sum, carry := bits.Add(a, b, 0)
+	return sum, carry == 0
+}
+
+/*
+Create a dense store over an existing slice without copying. Row i is read from
+data[i*stride : i*stride+columns].
+*/
+func NewDenseStoreFromSlice[T Element](data []T, rows, columns, stride uint) (*DenseStore[T], error) {
+	store := &DenseStore[T]{
+		rows:    rows,
+		columns: columns,
+		stride:  stride,
+		data:    data,
+	}
+
+	err := store.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+// Read a value from a position in the matrix
+func (d *DenseStore[T]) Read(i, j uint) T {
+	return d.data[i*d.stride+j]
+}
+
+// Get the dimensions of the underlying matrix data store
+func (d *DenseStore[T]) Shape() (uint, uint) {
+	return d.rows, d.columns
+}
+
+// Ensure that the slice is large enough for the dimensions and stride
+func (d *DenseStore[T]) Validate() error {
+	if d.rows == 0 || d.columns == 0 {
+		return ErrRowColumSize
+	}
+
+	if d.stride < d.columns {
+		return ErrStrideTooSmall(d.stride, d.columns)
+	}
+
+	offset, ok := checkedProduct(d.rows-1, d.stride)
+	required, carry := bits.Add(offset, d.columns, 0)
+	if !ok || carry != 0 {
+		return ErrMatrixTooLarge
+	}
+
+	if uint(len(d.data)) < required {
+		return ErrMatrixOverflow(uint(len(d.data)), required)
+	}
+
+	return nil
+}
+
+// Write a value to a position in the matrix
+func (d *DenseStore[T]) Write(i, j uint, value T) {
+	d.data[i*d.stride+j] = value
+}
+
+/*
+Return the backing slice and the stride between rows. The slice is not copied so
+writes to it are visible in the matrix.
+*/
+func (d *DenseStore[T]) Data() ([]T, uint) {
+	return d.data, d.stride
+}
+
+// Return row i of the store as a slice sharing the backing array
+func (d *DenseStore[T]) row(i uint) []T {
+	start := i * d.stride
+	return d.data[start : start+d.columns]
+}
+
+/*
+Return the backing slice and stride of a matrix using a dense store. The boolean is false
+when the matrix uses any other data store.
+*/
+func (m *Matrix[T]) DenseData() ([]T, uint, bool) {
+	d, ok := m.reader.(*DenseStore[T])
+	if !ok {
+		return nil, 0, false
+	}
+
+	data, stride := d.Data()
+	return data, stride, true
+}
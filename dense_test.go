@@ -0,0 +1,133 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestNewDenseStore(t *testing.T) {
+	t.Run("it allocates a single zero filled slice", func(t *testing.T) {
+		store, err := NewDenseStore[int](3, 4)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		data, stride := store.Data()
+		if len(data) != 12 || stride != 4 {
+			t.Errorf("expected 12 elements with stride 4, got %d with stride %d", len(data), stride)
+		}
+	})
+
+	t.Run("it returns an error if the dimensions are 0", func(t *testing.T) {
+		_, err := NewDenseStore[int](0, 3)
+		if !errors.Is(err, ErrRowColumSize) {
+			t.Errorf("expected ErrRowColumSize, got %v", err)
+		}
+	})
+
+	t.Run("it returns an error if the element count overflows", func(t *testing.T) {
+		_, err := NewDenseStore[int](math.MaxUint/2, 3)
+		if !errors.Is(err, ErrMatrixTooLarge) {
+			t.Errorf("expected ErrMatrixTooLarge, got %v", err)
+		}
+
+		_, err = NewDenseStore[int](math.MaxUint/16, 4)
+		if !errors.Is(err, ErrMatrixTooLarge) {
+			t.Errorf("expected ErrMatrixTooLarge for too many bytes, got %v", err)
+		}
+	})
+}
+
+func TestNewDenseStoreFromSlice(t *testing.T) {
+	t.Run("it reads a block of a wider slice using the stride", func(t *testing.T) {
+		data := []int{
+			1, 2, 3, 9,
+			4, 5, 6, 9,
+		}
+		store, err := NewDenseStoreFromSlice(data, 2, 3, 4)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		m, _ := NewMatrix[int](store)
+		want, _ := NewMatrixFromSlice([][]int{
+			{1, 2, 3},
+			{4, 5, 6},
+		})
+
+		matrixesAreEquivalent(t, m, want)
+
+		if !reflect.DeepEqual(m.Flatten(), []int{1, 2, 3, 4, 5, 6}) {
+			t.Errorf("expected padding to be skipped, got %v", m.Flatten())
+		}
+	})
+
+	t.Run("it shares the slice with the matrix", func(t *testing.T) {
+		data := []int{1, 2, 3, 4}
+		store, _ := NewDenseStoreFromSlice(data, 2, 2, 2)
+		m, _ := NewMatrix[int](store)
+
+		m.Set(1, 0, 7)
+
+		if data[2] != 7 {
+			t.Errorf("expected write to be visible in the slice, got %v", data)
+		}
+	})
+
+	t.Run("it returns an error if the stride is smaller than the column count", func(t *testing.T) {
+		_, err := NewDenseStoreFromSlice([]int{1, 2, 3, 4}, 2, 2, 1)
+		if err == nil {
+			t.Error("expected error but got none")
+		}
+	})
+
+	t.Run("it returns an error if the slice is too small", func(t *testing.T) {
+		_, err := NewDenseStoreFromSlice([]int{1, 2, 3}, 2, 2, 2)
+		if err == nil {
+			t.Error("expected error but got none")
+		}
+	})
+
+	t.Run("it returns an error if the required length overflows", func(t *testing.T) {
+		_, err := NewDenseStoreFromSlice([]int{1, 2, 3}, 3, 2, math.MaxUint/2)
+		if !errors.Is(err, ErrMatrixTooLarge) {
+			t.Errorf("expected ErrMatrixTooLarge, got %v", err)
+		}
+	})
+}
+
+func TestDenseData(t *testing.T) {
+	t.Run("it returns the backing slice of an empty matrix", func(t *testing.T) {
+		m, _ := NewIdentityMatrix[int](2)
+
+		data, stride, ok := m.DenseData()
+		if !ok {
+			t.Fatal("expected a dense store")
+		}
+
+		if !reflect.DeepEqual(data, []int{1, 0, 0, 1}) || stride != 2 {
+			t.Errorf("got %v with stride %d", data, stride)
+		}
+	})
+
+	t.Run("it returns false for other data stores", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice([][]int{{1}})
+
+		_, _, ok := m.DenseData()
+		if ok {
+			t.Error("expected ok to be false, got true")
+		}
+	})
+
+	t.Run("operations return dense results", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice([][]int{{1, 2}, {3, 4}})
+		b, _ := a.Multiply(a)
+
+		_, _, ok := b.DenseData()
+		if !ok {
+			t.Error("expected a dense store")
+		}
+	})
+}
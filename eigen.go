@@ -0,0 +1,486 @@
+package matrix
+
+import (
+	"math"
+	"sort"
+)
+
+// The most Jacobi sweeps or QR iterations per eigenvalue before giving up
+const maxEigenIterations = 100
+
+/*
+The eigen-decomposition of a symmetric matrix, A = VDV^T where D holds the eigenvalues
+on its diagonal and the columns of V are the matching orthonormal eigenvectors.
+*/
+type SymmetricEigen struct {
+	values  []float64
+	vectors [][]float64
+}
+
+/*
+Compute the eigenvalues and eigenvectors of a symmetric matrix using cyclic Jacobi
+rotations. Any element type is accepted and converted to float64. Returns
+ErrNotSymmetric if the upper and lower triangles differ by more than rounding error,
+otherwise the lower triangle is used.
+*/
+func NewSymmetricEigen[T Element](m *Matrix[T]) (*SymmetricEigen, error) {
+	if m.rows != m.columns {
+		return nil, ErrMatrixMustBeSquare
+	}
+
+	n := m.rows
+
+	a := make([][]float64, n)
+	v := make([][]float64, n)
+	for i := uint(0); i < n; i++ {
+		a[i] = make([]float64, n)
+		v[i] = make([]float64, n)
+		v[i][i] = 1
+		for j := uint(0); j < n; j++ {
+			a[i][j] = float64(m.reader.Read(i, j))
+		}
+	}
+
+	if !isSymmetric(n, func(i, j uint) float64 { return a[i][j] }) {
+		return nil, ErrNotSymmetric
+	}
+
+	for i := uint(0); i < n; i++ {
+		for j := uint(0); j < i; j++ {
+			a[j][i] = a[i][j]
+		}
+	}
+
+	var total float64
+	for i := range a {
+		for j := range a[i] {
+			total += a[i][j] * a[i][j]
+		}
+	}
+
+	converged := false
+
+	for sweep := 0; sweep < maxEigenIterations; sweep++ {
+		var off float64
+		for p := uint(0); p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				off += a[p][q] * a[p][q]
+			}
+		}
+
+		// Stop once the off diagonal elements are negligible next to the whole matrix
+		if off <= 1e-30*total {
+			converged = true
+			break
+		}
+
+		for p := uint(0); p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				if a[p][q] == 0 {
+					continue
+				}
+
+				// Choose the rotation that zeroes a[p][q], taking the smaller angle for stability
+				theta := (a[q][q] - a[p][p]) / (2 * a[p][q])
+				t := 1 / (math.Abs(theta) + math.Sqrt(theta*theta+1))
+				if theta < 0 {
+					t = -t
+				}
+				c := 1 / math.Sqrt(t*t+1)
+				s := t * c
+
+				for k := uint(0); k < n; k++ {
+					akp, akq := a[k][p], a[k][q]
+					a[k][p] = c*akp - s*akq
+					a[k][q] = s*akp + c*akq
+				}
+
+				for k := uint(0); k < n; k++ {
+					apk, aqk := a[p][k], a[q][k]
+					a[p][k] = c*apk - s*aqk
+					a[q][k] = s*apk + c*aqk
+				}
+
+				for k := uint(0); k < n; k++ {
+					vkp, vkq := v[k][p], v[k][q]
+					v[k][p] = c*vkp - s*vkq
+					v[k][q] = s*vkp + c*vkq
+				}
+			}
+		}
+	}
+
+	if !converged {
+		return nil, ErrNoConvergence
+	}
+
+	// Sort the eigenvalues into ascending order, moving the eigenvectors with them
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(x, y int) bool {
+		return a[order[x]][order[x]] < a[order[y]][order[y]]
+	})
+
+	values := make([]float64, n)
+	vectors := make([][]float64, n)
+	for i := range vectors {
+		vectors[i] = make([]float64, n)
+	}
+
+	for h, k := range order {
+		values[h] = a[k][k]
+		for i := uint(0); i < n; i++ {
+			vectors[i][h] = v[i][k]
+		}
+	}
+
+	return &SymmetricEigen{values: values, vectors: vectors}, nil
+}
+
+// Return the eigenvalues in ascending order
+func (e *SymmetricEigen) Values() []float64 {
+	values := make([]float64, len(e.values))
+	copy(values, e.values)
+	return values
+}
+
+/*
+Return the eigenvectors as the columns of an orthonormal matrix, column j belongs to
+eigenvalue j of Values.
+*/
+func (e *SymmetricEigen) Vectors() *Matrix[float64] {
+	n := uint(len(e.values))
+	v, _ := NewEmptyMatrix[float64](n, n)
+
+	for i := uint(0); i < n; i++ {
+		for j := uint(0); j < n; j++ {
+			v.writer.Write(i, j, e.vectors[i][j])
+		}
+	}
+
+	return v
+}
+
+/*
+Compute the eigenvalues of a general square matrix by reducing it to upper Hessenberg
+form and applying the shifted double step QR algorithm. Any element type is accepted and
+converted to float64. Returns the real and imaginary parts as two slices, element h of
+each describing the same eigenvalue. Complex eigenvalues appear as adjacent conjugate
+pairs and the eigenvalues are ordered by real part then imaginary part.
+*/
+func Eigenvalues[T Element](m *Matrix[T]) ([]float64, []float64, error) {
+	if m.rows != m.columns {
+		return nil, nil, ErrMatrixMustBeSquare
+	}
+
+	n := int(m.rows)
+
+	h := make([][]float64, n)
+	for i := 0; i < n; i++ {
+		h[i] = make([]float64, n)
+		for j := 0; j < n; j++ {
+			h[i][j] = float64(m.reader.Read(uint(i), uint(j)))
+		}
+	}
+
+	hessenberg(h)
+
+	re, im, err := hessenbergEigenvalues(h)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(x, y int) bool {
+		a, b := order[x], order[y]
+		if re[a] != re[b] {
+			return re[a] < re[b]
+		}
+		return im[a] < im[b]
+	})
+
+	sortedReal := make([]float64, n)
+	sortedImag := make([]float64, n)
+	for i, k := range order {
+		sortedReal[i], sortedImag[i] = re[k], im[k]
+	}
+
+	return sortedReal, sortedImag, nil
+}
+
+/*
+Reduce a square matrix in place to upper Hessenberg form, zero below the first
+subdiagonal, using Householder reflections. The eigenvalues are unchanged.
+*/
+func hessenberg(h [][]float64) {
+	n := len(h)
+	high := n - 1
+	ort := make([]float64, n)
+
+	for m := 1; m < high; m++ {
+		var scale float64
+		for i := m; i <= high; i++ {
+			scale += math.Abs(h[i][m-1])
+		}
+
+		if scale == 0 {
+			continue
+		}
+
+		var sum float64
+		for i := high; i >= m; i-- {
+			ort[i] = h[i][m-1] / scale
+			sum += ort[i] * ort[i]
+		}
+
+		g := math.Sqrt(sum)
+		if ort[m] > 0 {
+			g = -g
+		}
+		sum -= ort[m] * g
+		ort[m] -= g
+
+		// Apply the reflection I - u u^T / sum from the left then the right
+		for j := m; j < n; j++ {
+			var f float64
+			for i := high; i >= m; i-- {
+				f += ort[i] * h[i][j]
+			}
+			f /= sum
+			for i := m; i <= high; i++ {
+				h[i][j] -= f * ort[i]
+			}
+		}
+
+		for i := 0; i <= high; i++ {
+			var f float64
+			for j := high; j >= m; j-- {
+				f += ort[j] * h[i][j]
+			}
+			f /= sum
+			for j := m; j <= high; j++ {
+				h[i][j] -= f * ort[j]
+			}
+		}
+
+		ort[m] *= scale
+		h[m][m-1] = scale * g
+	}
+}
+
+/*
+Find the eigenvalues of an upper Hessenberg matrix with the Francis double shift QR
+algorithm, deflating one real root or a pair of roots whenever a subdiagonal element
+becomes negligible. The matrix is overwritten.
+*/
+func hessenbergEigenvalues(h [][]float64) ([]float64, []float64, error) {
+	nn := len(h)
+	re := make([]float64, nn)
+	im := make([]float64, nn)
+
+	eps := math.Nextafter(1, 2) - 1
+	var exshift, p, q, r, s, z, t, w, x, y float64
+
+	var norm float64
+	for i := 0; i < nn; i++ {
+		for j := max(i-1, 0); j < nn; j++ {
+			norm += math.Abs(h[i][j])
+		}
+	}
+
+	n := nn - 1
+	iter := 0
+
+	for n >= 0 {
+		// Look for a single small subdiagonal element
+		l := n
+		for l > 0 {
+			s = math.Abs(h[l-1][l-1]) + math.Abs(h[l][l])
+			if s == 0 {
+				s = norm
+			}
+			if math.Abs(h[l][l-1]) < eps*s {
+				break
+			}
+			l--
+		}
+
+		if l == n {
+			// One real root
+			re[n] = h[n][n] + exshift
+			im[n] = 0
+			n--
+			iter = 0
+			continue
+		}
+
+		if l == n-1 {
+			// Two roots from the trailing 2x2 block
+			w = h[n][n-1] * h[n-1][n]
+			p = (h[n-1][n-1] - h[n][n]) / 2
+			q = p*p + w
+			z = math.Sqrt(math.Abs(q))
+			x = h[n][n] + exshift
+
+			if q >= 0 {
+				if p >= 0 {
+					z = p + z
+				} else {
+					z = p - z
+				}
+				re[n-1] = x + z
+				re[n] = re[n-1]
+				if z != 0 {
+					re[n] = x - w/z
+				}
+				im[n-1], im[n] = 0, 0
+			} else {
+				re[n-1], re[n] = x+p, x+p
+				im[n-1], im[n] = z, -z
+			}
+
+			n -= 2
+			iter = 0
+			continue
+		}
+
+		if iter == maxEigenIterations {
+			return nil, nil, ErrNoConvergence
+		}
+
+		x = h[n][n]
+		y = h[n-1][n-1]
+		w = h[n][n-1] * h[n-1][n]
+
+		// Exceptional shifts break cycles that the standard shift can fall into
+		if iter == 10 {
+			exshift += x
+			for i := 0; i <= n; i++ {
+				h[i][i] -= x
+			}
+			s = math.Abs(h[n][n-1]) + math.Abs(h[n-1][n-2])
+			x = 0.75 * s
+			y = x
+			w = -0.4375 * s * s
+		}
+
+		if iter == 30 {
+			s = (y - x) / 2
+			s = s*s + w
+			if s > 0 {
+				s = math.Sqrt(s)
+				if y < x {
+					s = -s
+				}
+				s = x - w/((y-x)/2+s)
+				for i := 0; i <= n; i++ {
+					h[i][i] -= s
+				}
+				exshift += s
+				x, y, w = 0.964, 0.964, 0.964
+			}
+		}
+
+		iter++
+
+		// Look for two consecutive small subdiagonal elements
+		m := n - 2
+		for m >= l {
+			z = h[m][m]
+			r = x - z
+			s = y - z
+			p = (r*s-w)/h[m+1][m] + h[m][m+1]
+			q = h[m+1][m+1] - z - r - s
+			r = h[m+2][m+1]
+			s = math.Abs(p) + math.Abs(q) + math.Abs(r)
+			p /= s
+			q /= s
+			r /= s
+
+			if m == l {
+				break
+			}
+			if math.Abs(h[m][m-1])*(math.Abs(q)+math.Abs(r)) < eps*(math.Abs(p)*(math.Abs(h[m-1][m-1])+math.Abs(z)+math.Abs(h[m+1][m+1]))) {
+				break
+			}
+			m--
+		}
+
+		for i := m + 2; i <= n; i++ {
+			h[i][i-2] = 0
+			if i > m+2 {
+				h[i][i-3] = 0
+			}
+		}
+
+		// Double QR step on rows l to n and columns m to n
+		for k := m; k <= n-1; k++ {
+			notLast := k != n-1
+
+			if k != m {
+				p = h[k][k-1]
+				q = h[k+1][k-1]
+				r = 0
+				if notLast {
+					r = h[k+2][k-1]
+				}
+				x = math.Abs(p) + math.Abs(q) + math.Abs(r)
+				if x == 0 {
+					continue
+				}
+				p /= x
+				q /= x
+				r /= x
+			}
+
+			s = math.Sqrt(p*p + q*q + r*r)
+			if p < 0 {
+				s = -s
+			}
+
+			if s == 0 {
+				continue
+			}
+
+			if k != m {
+				h[k][k-1] = -s * x
+			} else if l != m {
+				h[k][k-1] = -h[k][k-1]
+			}
+
+			p += s
+			x = p / s
+			y = q / s
+			z = r / s
+			q /= p
+			r /= p
+
+			for j := k; j < nn; j++ {
+				t = h[k][j] + q*h[k+1][j]
+				if notLast {
+					t += r * h[k+2][j]
+					h[k+2][j] -= t * z
+				}
+				h[k][j] -= t * x
+				h[k+1][j] -= t * y
+			}
+
+			for i := 0; i <= min(n, k+3); i++ {
+				t = x*h[i][k] + y*h[i][k+1]
+				if notLast {
+					t += z * h[i][k+2]
+					h[i][k+2] -= t * r
+				}
+				h[i][k] -= t
+				h[i][k+1] -= t * q
+			}
+		}
+	}
+
+	return re, im, nil
+}
@@ -0,0 +1,189 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestNewSymmetricEigen(t *testing.T) {
+	input := [][]float64{
+		{4, 1, -2, 2},
+		{1, 2, 0, 1},
+		{-2, 0, 3, -2},
+		{2, 1, -2, -1},
+	}
+
+	t.Run("it decomposes the matrix so that AV = VD", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice(input)
+
+		e, err := NewSymmetricEigen(a)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		values := e.Values()
+		for i := 1; i < len(values); i++ {
+			if values[i-1] > values[i] {
+				t.Errorf("expected ascending eigenvalues, got %v", values)
+			}
+		}
+
+		v := e.Vectors()
+		d, _ := NewEmptyMatrix[float64](4, 4)
+		for i, value := range values {
+			d.writer.Write(uint(i), uint(i), value)
+		}
+
+		av, _ := a.Multiply(v)
+		vd, _ := v.Multiply(d)
+		matrixesAreClose(t, av, vd, 1e-10)
+	})
+
+	t.Run("it returns orthonormal eigenvectors", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice(input)
+		e, _ := NewSymmetricEigen(a)
+
+		v := e.Vectors()
+		vt, _ := v.Transpose()
+		product, _ := vt.Multiply(v)
+
+		identity, _ := NewIdentityMatrix[float64](4)
+		matrixesAreClose(t, product, identity, 1e-12)
+	})
+
+	t.Run("it converts an integer matrix", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice([][]int{
+			{2, 1},
+			{1, 2},
+		})
+
+		e, err := NewSymmetricEigen(a)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		values := e.Values()
+		if math.Abs(values[0]-1) > 1e-12 || math.Abs(values[1]-3) > 1e-12 {
+			t.Errorf("expected eigenvalues [1 3], got %v", values)
+		}
+	})
+
+	t.Run("it returns an error if the matrix is not square", func(t *testing.T) {
+		a, _ := NewEmptyMatrix[float64](2, 3)
+
+		_, err := NewSymmetricEigen(a)
+		if !errors.Is(err, ErrMatrixMustBeSquare) {
+			t.Errorf("expected ErrMatrixMustBeSquare, got %v", err)
+		}
+	})
+
+	t.Run("it returns ErrNotSymmetric if the triangles differ", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice([][]float64{
+			{1, 100},
+			{0, 2},
+		})
+
+		_, err := NewSymmetricEigen(a)
+		if !errors.Is(err, ErrNotSymmetric) {
+			t.Errorf("expected ErrNotSymmetric, got %v", err)
+		}
+	})
+}
+
+func TestEigenvalues(t *testing.T) {
+	assertEigenvalues := func(t *testing.T, gotRe, gotIm, wantRe, wantIm []float64) {
+		t.Helper()
+
+		if len(gotRe) != len(wantRe) || len(gotIm) != len(wantIm) {
+			t.Fatalf("expected %d eigenvalues, got %d", len(wantRe), len(gotRe))
+		}
+
+		for i := range wantRe {
+			if math.Abs(gotRe[i]-wantRe[i]) > 1e-9 || math.Abs(gotIm[i]-wantIm[i]) > 1e-9 {
+				t.Errorf("expected eigenvalue %v%+vi at %d, got %v%+vi", wantRe[i], wantIm[i], i, gotRe[i], gotIm[i])
+			}
+		}
+	}
+
+	t.Run("it finds the real eigenvalues of a non-symmetric matrix", func(t *testing.T) {
+		// A similarity transform of a triangular matrix with eigenvalues 1, 2 and 3
+		a, _ := NewMatrixFromSlice([][]float64{
+			{2, 0, 0},
+			{1, 1, 0},
+			{-1, 2, 3},
+		})
+		p, _ := NewMatrixFromSlice([][]float64{
+			{1, 2, 0},
+			{0, 1, 1},
+			{1, 0, 1},
+		})
+		lu, _ := NewLU(p)
+		ap, _ := a.Multiply(p)
+		similar, _ := lu.Solve(ap)
+
+		re, im, err := Eigenvalues(similar)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assertEigenvalues(t, re, im, []float64{1, 2, 3}, []float64{0, 0, 0})
+	})
+
+	t.Run("it returns complex eigenvalues as conjugate pairs", func(t *testing.T) {
+		// A rotation by 90 degrees has eigenvalues -i and i
+		a, _ := NewMatrixFromSlice([][]int{
+			{0, -1},
+			{1, 0},
+		})
+
+		re, im, err := Eigenvalues(a)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assertEigenvalues(t, re, im, []float64{0, 0}, []float64{-1, 1})
+	})
+
+	t.Run("it handles real and complex eigenvalues together", func(t *testing.T) {
+		// Eigenvalues 1 - 2i, 1 + 2i, 2 and 5
+		a, _ := NewMatrixFromSlice([][]float64{
+			{1, -2, 3, 1},
+			{2, 1, -1, 0},
+			{0, 0, 2, 4},
+			{0, 0, 0, 5},
+		})
+
+		re, im, err := Eigenvalues(a)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assertEigenvalues(t, re, im, []float64{1, 1, 2, 5}, []float64{-2, 2, 0, 0})
+	})
+
+	t.Run("it agrees with the symmetric solver", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice([][]float64{
+			{6, 2, 1},
+			{2, 3, 1},
+			{1, 1, 1},
+		})
+
+		e, _ := NewSymmetricEigen(a)
+		re, im, err := Eigenvalues(a)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assertEigenvalues(t, re, im, e.Values(), []float64{0, 0, 0})
+	})
+
+	t.Run("it returns an error if the matrix is not square", func(t *testing.T) {
+		a, _ := NewEmptyMatrix[float64](3, 2)
+
+		_, _, err := Eigenvalues(a)
+		if !errors.Is(err, ErrMatrixMustBeSquare) {
+			t.Errorf("expected ErrMatrixMustBeSquare, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,295 @@
+package matrix
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"math"
+	"math/bits"
+	"reflect"
+)
+
+// Identifies the binary encoding produced by MarshalBinary
+var binaryMagic = []byte("GOMX")
+
+const binaryVersion byte = 2
+
+// The magic, version, element type code, element width in bits, rows and columns
+const binaryHeaderSize = 4 + 1 + 1 + 1 + 8 + 8
+
+// Element type codes recorded in the binary header, never reorder these
+var elementTypeCodes = map[string]byte{
+	"int":     1,
+	"int8":    2,
+	"int16":   3,
+	"int32":   4,
+	"int64":   5,
+	"uint":    6,
+	"uint8":   7,
+	"uint16":  8,
+	"uint32":  9,
+	"uint64":  10,
+	"float32": 11,
+	"float64": 12,
+}
+
+// The JSON representation of a matrix, the data is nested one array per row
+type matrixJSON[T Element] struct {
+	Type    string `json:"type"`
+	Rows    uint   `json:"rows"`
+	Columns uint   `json:"columns"`
+	Data    [][]T  `json:"data"`
+}
+
+/*
+Encode the matrix as JSON with its element type and shape alongside the rows of data,
+for example {"type":"int","rows":2,"columns":2,"data":[[1,2],[3,4]]}
+*/
+func (m Matrix[T]) MarshalJSON() ([]byte, error) {
+	data := make([][]T, m.rows)
+	for i := uint(0); i < m.rows; i++ {
+		data[i] = make([]T, m.columns)
+		for j := uint(0); j < m.columns; j++ {
+			data[i][j] = m.reader.Read(i, j)
+		}
+	}
+
+	return json.Marshal(matrixJSON[T]{
+		Type:    elementTypeName[T](),
+		Rows:    m.rows,
+		Columns: m.columns,
+		Data:    data,
+	})
+}
+
+/*
+Decode a matrix encoded by MarshalJSON into a new dense store. The type field may be
+omitted, if present it must match T. Decoding expects a fresh Matrix such as a zero
+value, the receiver's store is replaced and any index dropped, so views over an existing
+matrix become stale. Returns ErrReadOnly for a read only matrix.
+*/
+func (m *Matrix[T]) UnmarshalJSON(b []byte) error {
+	var decoded matrixJSON[T]
+
+	err := json.Unmarshal(b, &decoded)
+	if err != nil {
+		return err
+	}
+
+	if decoded.Type != "" && decoded.Type != elementTypeName[T]() {
+		return ErrElementTypeMismatch(decoded.Type, elementTypeName[T]())
+	}
+
+	if uint(len(decoded.Data)) != decoded.Rows {
+		return ErrShapeMismatch(decoded.Rows, decoded.Columns, uint(len(decoded.Data)), decoded.Columns)
+	}
+
+	// Check every row before allocating so the store is no larger than the decoded data
+	for i, row := range decoded.Data {
+		if uint(len(row)) != decoded.Columns {
+			return ErrColumnCountMismatch(i)
+		}
+	}
+
+	store, err := NewDenseStore[T](decoded.Rows, decoded.Columns)
+	if err != nil {
+		return err
+	}
+
+	for i, row := range decoded.Data {
+		copy(store.row(uint(i)), row)
+	}
+
+	return m.replaceStore(store)
+}
+
+/*
+Encode the matrix in a compact binary form. A little endian header records a version,
+the element type, the element width and the shape, followed by the elements in row major
+order. The width tells int and uint data written by 32 and 64 bit builds apart. Also
+used by encoding/gob.
+*/
+func (m Matrix[T]) MarshalBinary() ([]byte, error) {
+	kind, size := elementKind[T]()
+
+	buf := make([]byte, binaryHeaderSize, binaryHeaderSize+int(m.rows*m.columns)*size)
+	copy(buf, binaryMagic)
+	buf[4] = binaryVersion
+	buf[5] = elementTypeCodes[elementTypeName[T]()]
+	buf[6] = byte(size * 8)
+	binary.LittleEndian.PutUint64(buf[7:], uint64(m.rows))
+	binary.LittleEndian.PutUint64(buf[15:], uint64(m.columns))
+
+	element := make([]byte, size)
+	for i := uint(0); i < m.rows; i++ {
+		for j := uint(0); j < m.columns; j++ {
+			encodeElement(element, kind, m.reader.Read(i, j))
+			buf = append(buf, element...)
+		}
+	}
+
+	return buf, nil
+}
+
+/*
+Decode a matrix encoded by MarshalBinary into a new dense store. The encoded element
+type and width must match T. Like UnmarshalJSON this expects a fresh Matrix and returns
+ErrReadOnly for a read only matrix.
+*/
+func (m *Matrix[T]) UnmarshalBinary(data []byte) error {
+	if len(data) < binaryHeaderSize || !bytes.Equal(data[:4], binaryMagic) {
+		return ErrBinaryHeader
+	}
+
+	if data[4] != binaryVersion {
+		return ErrBinaryVersion(data[4])
+	}
+
+	name := elementTypeName[T]()
+	if data[5] != elementTypeCodes[name] {
+		return ErrElementTypeMismatch(elementTypeFromCode(data[5]), name)
+	}
+
+	kind, size := elementKind[T]()
+	if int(data[6]) != size*8 {
+		return ErrElementWidthMismatch(int(data[6]), size*8)
+	}
+
+	rows := binary.LittleEndian.Uint64(data[7:])
+	columns := binary.LittleEndian.Uint64(data[15:])
+	body := data[binaryHeaderSize:]
+
+	// Check each product for overflow so a corrupt header cannot pass with a short body
+	hi, count := bits.Mul64(rows, columns)
+	if hi != 0 {
+		return ErrBinaryHeader
+	}
+	hi, length := bits.Mul64(count, uint64(size))
+	if hi != 0 || length != uint64(len(body)) {
+		return ErrBinaryHeader
+	}
+
+	store, err := NewDenseStore[T](uint(rows), uint(columns))
+	if err != nil {
+		return err
+	}
+
+	for h := range store.data {
+		store.data[h] = decodeElement[T](body[h*size:(h+1)*size], kind, binary.LittleEndian)
+	}
+
+	return m.replaceStore(store)
+}
+
+/*
+Point the matrix at a new data store, the old index no longer applies. A zero value
+Matrix has no reader, only a matrix with a reader but no writer is read only.
+*/
+func (m *Matrix[T]) replaceStore(store *DenseStore[T]) error {
+	if m.reader != nil && m.IsReadOnly() {
+		return ErrReadOnly
+	}
+
+	err := store.Validate()
+	if err != nil {
+		return err
+	}
+
+	m.rows, m.columns = store.Shape()
+	m.reader = store
+	m.writer = store
+	m.generation++
+	m.DropIndex()
+	return nil
+}
+
+// The Go name of the element type, such as float64
+func elementTypeName[T Element]() string {
+	var v T
+	return reflect.TypeOf(v).String()
+}
+
+func elementTypeFromCode(code byte) string {
+	for name, c := range elementTypeCodes {
+		if c == code {
+			return name
+		}
+	}
+	return "unknown"
+}
+
+/*
+The kind and item size in bytes that T is stored as in binary formats. The kind is
+'i' for signed integers, 'u' for unsigned integers and 'f' for floats, matching the
+NumPy dtype kinds.
+*/
+func elementKind[T Element]() (byte, int) {
+	var v T
+	t := reflect.TypeOf(v)
+
+	switch t.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return 'f', t.Bits() / 8
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return 'i', t.Bits() / 8
+	default:
+		return 'u', t.Bits() / 8
+	}
+}
+
+// Decode a value of the given kind and size from buf
+func decodeElement[T Element](buf []byte, kind byte, order binary.ByteOrder) T {
+	var bits uint64
+	switch len(buf) {
+	case 1:
+		bits = uint64(buf[0])
+	case 2:
+		bits = uint64(order.Uint16(buf))
+	case 4:
+		bits = uint64(order.Uint32(buf))
+	case 8:
+		bits = order.Uint64(buf)
+	}
+
+	switch kind {
+	case 'f':
+		if len(buf) == 4 {
+			return T(math.Float32frombits(uint32(bits)))
+		}
+		return T(math.Float64frombits(bits))
+	case 'i':
+		// Shift the sign bit to the top so the conversion to int64 sign extends
+		shift := 64 - 8*len(buf)
+		return T(int64(bits<<shift) >> shift)
+	default:
+		return T(bits)
+	}
+}
+
+// Encode a value into buf as little endian, buf must be the item size of the kind
+func encodeElement[T Element](buf []byte, kind byte, v T) {
+	var bits uint64
+	switch kind {
+	case 'f':
+		if len(buf) == 4 {
+			bits = uint64(math.Float32bits(float32(v)))
+		} else {
+			bits = math.Float64bits(float64(v))
+		}
+	case 'i':
+		bits = uint64(int64(v))
+	default:
+		bits = uint64(v)
+	}
+
+	switch len(buf) {
+	case 1:
+		buf[0] = byte(bits)
+	case 2:
+		binary.LittleEndian.PutUint16(buf, uint16(bits))
+	case 4:
+		binary.LittleEndian.PutUint32(buf, uint32(bits))
+	case 8:
+		binary.LittleEndian.PutUint64(buf, bits)
+	}
+}
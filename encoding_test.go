@@ -0,0 +1,238 @@
+package matrix
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestMarshalJSON(t *testing.T) {
+	t.Run("it encodes the shape and nested rows", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice([][]int{
+			{1, 2, 3},
+			{4, 5, 6},
+		})
+
+		got, err := json.Marshal(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := `{"type":"int","rows":2,"columns":3,"data":[[1,2,3],[4,5,6]]}`
+		if string(got) != want {
+			t.Errorf("expected %s, got %s", want, got)
+		}
+	})
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	t.Run("it decodes without a type field", func(t *testing.T) {
+		var got Matrix[float32]
+
+		err := json.Unmarshal([]byte(`{"rows":1,"columns":2,"data":[[1.5,-2]]}`), &got)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := NewMatrixFromSlice([][]float32{{1.5, -2}})
+		matrixesAreEquivalent(t, &got, want)
+	})
+
+	t.Run("it drops an existing index", func(t *testing.T) {
+		m, _ := NewEmptyMatrix[int](1, 1)
+		m.Index()
+
+		json.Unmarshal([]byte(`{"rows":1,"columns":1,"data":[[3]]}`), m)
+
+		if m.HasIndex() {
+			t.Error("expected HasIndex to return false, got true")
+		}
+	})
+
+	cases := []struct {
+		name  string
+		input string
+	}{
+		{name: "type mismatch", input: `{"type":"float64","rows":1,"columns":1,"data":[[1]]}`},
+		{name: "row count mismatch", input: `{"rows":2,"columns":1,"data":[[1]]}`},
+		{name: "column count mismatch", input: `{"rows":2,"columns":2,"data":[[1,2],[3]]}`},
+		{name: "columns larger than the data", input: `{"rows":1,"columns":100000000000,"data":[[1]]}`},
+		{name: "empty", input: `{"rows":0,"columns":0,"data":[]}`},
+		{name: "overflow", input: `{"rows":1,"columns":1,"data":[[300]]}`},
+	}
+
+	for _, test := range cases {
+		t.Run("it returns an error for "+test.name, func(t *testing.T) {
+			var m Matrix[int8]
+
+			err := json.Unmarshal([]byte(test.input), &m)
+			if err == nil {
+				t.Error("expected error but got none")
+			}
+		})
+	}
+}
+
+func TestMarshalBinary(t *testing.T) {
+	t.Run("it writes a versioned little endian header", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice([][]uint16{{1, 258}})
+
+		got, err := m.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := []byte{
+			'G', 'O', 'M', 'X', 2, 8, 16,
+			1, 0, 0, 0, 0, 0, 0, 0,
+			2, 0, 0, 0, 0, 0, 0, 0,
+			1, 0, 2, 1,
+		}
+
+		if !bytes.Equal(got, want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	})
+}
+
+func TestUnmarshalBinary(t *testing.T) {
+	m, _ := NewMatrixFromSlice([][]int32{{1, 2}, {3, 4}})
+	valid, _ := m.MarshalBinary()
+
+	t.Run("it returns an error for a different element type", func(t *testing.T) {
+		var got Matrix[float32]
+
+		err := got.UnmarshalBinary(valid)
+		if err == nil || err.Error() != ErrElementTypeMismatch("int32", "float32").Error() {
+			t.Errorf("expected %v, got %v", ErrElementTypeMismatch("int32", "float32"), err)
+		}
+	})
+
+	t.Run("it returns an error for an unknown version", func(t *testing.T) {
+		data := append([]byte{}, valid...)
+		data[4] = 9
+
+		var got Matrix[int32]
+		err := got.UnmarshalBinary(data)
+		if err == nil || err.Error() != ErrBinaryVersion(9).Error() {
+			t.Errorf("expected %v, got %v", ErrBinaryVersion(9), err)
+		}
+	})
+
+	t.Run("it returns an error for a different element width", func(t *testing.T) {
+		if strconv.IntSize != 64 {
+			t.Skip("needs a 64 bit int")
+		}
+
+		// The int32 data relabelled as an int written by a 32 bit build
+		data := append([]byte{}, valid...)
+		data[5] = elementTypeCodes["int"]
+
+		var got Matrix[int]
+		err := got.UnmarshalBinary(data)
+		if err == nil || err.Error() != ErrElementWidthMismatch(32, 64).Error() {
+			t.Errorf("expected %v, got %v", ErrElementWidthMismatch(32, 64), err)
+		}
+	})
+
+	t.Run("it returns ErrReadOnly for a read only matrix", func(t *testing.T) {
+		got, _ := NewMatrix[int32](newReadOnlyStore([][]int32{{0, 0}, {0, 0}}))
+
+		err := got.UnmarshalBinary(valid)
+		if !errors.Is(err, ErrReadOnly) {
+			t.Errorf("expected ErrReadOnly, got %v", err)
+		}
+
+		err = got.UnmarshalJSON([]byte(`{"rows":1,"columns":1,"data":[[1]]}`))
+		if !errors.Is(err, ErrReadOnly) {
+			t.Errorf("expected ErrReadOnly from UnmarshalJSON, got %v", err)
+		}
+	})
+
+	t.Run("it returns an error for truncated data", func(t *testing.T) {
+		var got Matrix[int32]
+
+		err := got.UnmarshalBinary(valid[:len(valid)-1])
+		if !errors.Is(err, ErrBinaryHeader) {
+			t.Errorf("expected ErrBinaryHeader, got %v", err)
+		}
+	})
+
+	t.Run("it returns an error when the shape overflows", func(t *testing.T) {
+		// 2^33 * 2^31 * 4 bytes wraps to zero in 64 bits, matching the empty body
+		data := append([]byte{}, valid[:binaryHeaderSize]...)
+		binary.LittleEndian.PutUint64(data[7:], 1<<33)
+		binary.LittleEndian.PutUint64(data[15:], 1<<31)
+
+		var got Matrix[int32]
+		err := got.UnmarshalBinary(data)
+		if !errors.Is(err, ErrBinaryHeader) {
+			t.Errorf("expected ErrBinaryHeader, got %v", err)
+		}
+	})
+
+	t.Run("it returns an error for a bad magic string", func(t *testing.T) {
+		var got Matrix[int32]
+
+		err := got.UnmarshalBinary([]byte("not a matrix at all, no"))
+		if !errors.Is(err, ErrBinaryHeader) {
+			t.Errorf("expected ErrBinaryHeader, got %v", err)
+		}
+	})
+}
+
+func TestEncodingRoundTrip(t *testing.T) {
+	assertEncodingRoundTrip(t, [][]int{{-1, 1 << 40}, {0, 7}})
+	assertEncodingRoundTrip(t, [][]int8{{-128, 127}, {0, 7}})
+	assertEncodingRoundTrip(t, [][]int16{{-32768, 32767}, {0, 7}})
+	assertEncodingRoundTrip(t, [][]int32{{-1 << 31, 1<<31 - 1}, {0, 7}})
+	assertEncodingRoundTrip(t, [][]int64{{-1 << 63, 1<<63 - 1}, {0, 7}})
+	assertEncodingRoundTrip(t, [][]uint{{0, 1 << 63}, {0, 7}})
+	assertEncodingRoundTrip(t, [][]uint8{{0, 255}, {0, 7}})
+	assertEncodingRoundTrip(t, [][]uint16{{0, 65535}, {0, 7}})
+	assertEncodingRoundTrip(t, [][]uint32{{0, 1<<32 - 1}, {0, 7}})
+	assertEncodingRoundTrip(t, [][]uint64{{0, 1<<64 - 1}, {0, 7}})
+	assertEncodingRoundTrip(t, [][]float32{{-1.5, 3.25e10}, {0.1, 7}})
+	assertEncodingRoundTrip(t, [][]float64{{-1.5, 3.25e300}, {0.1, 7}})
+}
+
+// Check that JSON, binary and gob encoding all reproduce the matrix
+func assertEncodingRoundTrip[T Element](t *testing.T, data [][]T) {
+	t.Helper()
+
+	m, _ := NewMatrixFromSlice(data)
+
+	encoded, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("%T json: %v", data, err)
+	}
+	var fromJSON Matrix[T]
+	if err := json.Unmarshal(encoded, &fromJSON); err != nil {
+		t.Fatalf("%T json: %v", data, err)
+	}
+	matrixesAreEquivalent(t, &fromJSON, m)
+
+	encoded, err = m.MarshalBinary()
+	if err != nil {
+		t.Fatalf("%T binary: %v", data, err)
+	}
+	var fromBinary Matrix[T]
+	if err := fromBinary.UnmarshalBinary(encoded); err != nil {
+		t.Fatalf("%T binary: %v", data, err)
+	}
+	matrixesAreEquivalent(t, &fromBinary, m)
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(m); err != nil {
+		t.Fatalf("%T gob: %v", data, err)
+	}
+	var fromGob Matrix[T]
+	if err := gob.NewDecoder(&buf).Decode(&fromGob); err != nil {
+		t.Fatalf("%T gob: %v", data, err)
+	}
+	matrixesAreEquivalent(t, &fromGob, m)
+}
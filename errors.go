@@ -8,10 +8,24 @@ import (
 var (
 	ErrRowColumSize                    = errors.New("rows and columns must be greater than zero")
 	ErrMustBeSameDimensions            = errors.New("matrixes must be the same dimensions")
+	ErrMatrixTooLarge                  = errors.New("matrix dimensions overflow the addressable size")
 	ErrMatrixOutOfBounds               = errors.New("provided position exceeds matrix dimensions")
 	ErrIndexExists                     = errors.New("matrix already has an index")
 	ErrMultiplicationColumnRowMismatch = errors.New("param matrix row count must match receiver matrix column count")
 	ErrMatrixMustBeSquare              = errors.New("square matrix required, the columns and rows must be equal")
+	ErrNotPositiveDefinite             = errors.New("matrix is not symmetric positive definite")
+	ErrNotSymmetric                    = errors.New("matrix is not symmetric")
+	ErrNoConvergence                   = errors.New("iterative algorithm did not converge")
+	ErrReadOnly                        = errors.New("matrix is read only, the data store does not implement DataWriter")
+	ErrStaleView                       = errors.New("view is stale, its parent was reshaped after the view was created")
+	ErrSingularMatrix                  = errors.New("matrix is singular and has no inverse")
+	ErrSparseStructure                 = errors.New("sparse store row pointers and column indices are inconsistent or a zero is stored")
+	ErrArithmeticOverflow              = errors.New("arithmetic overflow")
+	ErrBinaryHeader                    = errors.New("invalid binary matrix header or length")
+	ErrSolveRowMismatch                = errors.New("param matrix row count must match decomposed matrix row count")
+	ErrIncompatibleShapes              = errors.New("matrix shapes cannot be broadcast together")
+	ErrInvalidPermutation              = errors.New("permutation must contain every index exactly once")
+	ErrUnknownNormKind                 = errors.New("unknown norm kind")
 )
 
 func ErrColumnCountMismatch(row int) error {
@@ -21,3 +35,71 @@ func ErrColumnCountMismatch(row int) error {
 func ErrMatrixOverflow(matrixSize, inputSize uint) error {
 	return fmt.Errorf("matrix has size: %d cannot fit input size: %d", matrixSize, inputSize)
 }
+
+func ErrStrideTooSmall(stride, columns uint) error {
+	return fmt.Errorf("stride %d is smaller than column count %d", stride, columns)
+}
+
+func ErrCSVValue(line, column int, value string) error {
+	return fmt.Errorf("invalid value %q at line %d column %d", value, line, column)
+}
+
+func ErrCSVOverflow(line, column int, value, elementType string) error {
+	return fmt.Errorf("value %q at line %d column %d overflows %s", value, line, column, elementType)
+}
+
+func ErrCSVColumnCountMismatch(line int, want, got uint) error {
+	return fmt.Errorf("column count mismatch at line %d, expected %d columns but got %d", line, want, got)
+}
+
+func ErrNPYHeader(reason string) error {
+	return fmt.Errorf("invalid npy header: %s", reason)
+}
+
+func ErrBinaryVersion(version byte) error {
+	return fmt.Errorf("unsupported binary matrix version %d", version)
+}
+
+func ErrElementTypeMismatch(got, want string) error {
+	return fmt.Errorf("encoded element type %s does not match %s", got, want)
+}
+
+func ErrElementWidthMismatch(got, want int) error {
+	return fmt.Errorf("encoded element width of %d bits does not match %d bits", got, want)
+}
+
+func ErrShapeMismatch(wantRows, wantColumns, gotRows, gotColumns uint) error {
+	return fmt.Errorf("expected shape %dx%d but got %dx%d", wantRows, wantColumns, gotRows, gotColumns)
+}
+
+func ErrMatrixMarket(line int, reason string) error {
+	return fmt.Errorf("matrix market line %d: %s", line, reason)
+}
+
+func ErrPercentileOutOfRange(p float64) error {
+	return fmt.Errorf("percentile %v is outside the range 0 to 100", p)
+}
+
+func ErrBroadcastShapes(rows, columns, otherRows, otherColumns uint) error {
+	return fmt.Errorf("%w: %dx%d and %dx%d", ErrIncompatibleShapes, rows, columns, otherRows, otherColumns)
+}
+
+func ErrReshapeSize(matrixSize, rows, columns uint) error {
+	return fmt.Errorf("matrix has size: %d cannot be reshaped to %dx%d", matrixSize, rows, columns)
+}
+
+func ErrStackRowMismatch(position int, want, got uint) error {
+	return fmt.Errorf("matrix %d has %d rows but %d are required to stack horizontally", position, got, want)
+}
+
+func ErrStackColumnMismatch(position int, want, got uint) error {
+	return fmt.Errorf("matrix %d has %d columns but %d are required to stack vertically", position, got, want)
+}
+
+func ErrSplitIndex(index, size uint) error {
+	return fmt.Errorf("split index %d must be increasing and between 1 and %d", index, size-1)
+}
+
+func ErrVectorLength(want, got uint) error {
+	return fmt.Errorf("expected %d values but got %d", want, got)
+}
@@ -0,0 +1,173 @@
+package matrix
+
+import "sort"
+
+/*
+Build an index of the matrix for cases where quicker lookup might be desired. Adds
+overhead for index storage and maintenance. Once built the index is kept in sync by
+every write made through the matrix, see write. A HashIndex is built unless another
+kind is given.
+*/
+func (m *Matrix[T]) Index(kind ...IndexKind) error {
+	if m.HasIndex() {
+		return ErrIndexExists
+	}
+
+	if isStaleView(m) {
+		return ErrStaleView
+	}
+
+	if len(kind) > 0 && kind[0] == OrderedIndex {
+		m.buildOrderedIndex()
+		return nil
+	}
+
+	index := make(map[T][][2]uint)
+	var nans [][2]uint
+
+	for i := uint(0); i < m.rows; i++ {
+		for j := uint(0); j < m.columns; j++ {
+			element := m.reader.Read(i, j)
+
+			// NaN never equals a map key, so NaN positions are kept in their own bucket
+			if element != element {
+				nans = append(nans, [2]uint{i, j})
+				continue
+			}
+
+			v, ok := index[element]
+			if ok {
+				index[element] = append(v, [2]uint{i, j})
+			} else {
+				index[element] = [][2]uint{{i, j}}
+			}
+		}
+	}
+
+	m.index = index
+	m.nans = nans
+	return nil
+}
+
+/*
+Remove the index from the matrix. Searches fall back to scanning the data store.
+*/
+func (m *Matrix[T]) DropIndex() {
+	m.index = nil
+	m.nans = nil
+	m.ordered = nil
+}
+
+/*
+Discard any existing index and build a new one of the same kind from the current data.
+Useful when the underlying data store has been modified without going through the matrix.
+*/
+func (m *Matrix[T]) Reindex() error {
+	kind := HashIndex
+	if m.ordered != nil {
+		kind = OrderedIndex
+	}
+
+	m.DropIndex()
+	return m.Index(kind)
+}
+
+/*
+Write a value to a position in the matrix, moving the position from the bucket of
+the old value to the bucket of the new value when the matrix has an index.
+*/
+func (m *Matrix[T]) write(i, j uint, value T) {
+	if m.index != nil {
+		old := m.reader.Read(i, j)
+		if old != value {
+			m.indexRemove(old, i, j)
+			m.indexAdd(value, i, j)
+		}
+	}
+
+	if m.ordered != nil {
+		old := m.reader.Read(i, j)
+		if old != value {
+			m.orderedRemove(old, i, j)
+			m.orderedAdd(value, i, j)
+		}
+	}
+
+	m.writer.Write(i, j, value)
+}
+
+/*
+Insert a position into the bucket for a value. Buckets are kept in row major order
+so indexed searches return locations in the same order as a full scan.
+*/
+func (m *Matrix[T]) indexAdd(value T, i, j uint) {
+	bucket := m.bucket(value)
+	position := [2]uint{i, j}
+
+	h := sort.Search(len(bucket), func(k int) bool {
+		return !positionLess(bucket[k], position)
+	})
+
+	if h < len(bucket) && bucket[h] == position {
+		return
+	}
+
+	bucket = append(bucket, [2]uint{})
+	copy(bucket[h+1:], bucket[h:])
+	bucket[h] = position
+	m.setBucket(value, bucket)
+}
+
+/*
+Remove a position from the bucket for a value, deleting the bucket entirely once it
+is empty so that searches for the value report not found.
+*/
+func (m *Matrix[T]) indexRemove(value T, i, j uint) {
+	bucket := m.bucket(value)
+	if len(bucket) == 0 {
+		return
+	}
+
+	position := [2]uint{i, j}
+
+	h := sort.Search(len(bucket), func(k int) bool {
+		return !positionLess(bucket[k], position)
+	})
+
+	if h == len(bucket) || bucket[h] != position {
+		return
+	}
+
+	m.setBucket(value, append(bucket[:h], bucket[h+1:]...))
+}
+
+// Return the positions holding a value, NaN positions come from their own bucket
+func (m *Matrix[T]) bucket(value T) [][2]uint {
+	if value != value {
+		return m.nans
+	}
+	return m.index[value]
+}
+
+// Replace the positions holding a value, deleting the map entry once it is empty
+func (m *Matrix[T]) setBucket(value T, bucket [][2]uint) {
+	if value != value {
+		m.nans = bucket
+		return
+	}
+
+	if len(bucket) == 0 {
+		delete(m.index, value)
+		return
+	}
+
+	m.index[value] = bucket
+}
+
+// Compare two positions in row major order
+func positionLess(a, b [2]uint) bool {
+	if a[0] != b[0] {
+		return a[0] < b[0]
+	}
+	return a[1] < b[1]
+}
@@ -0,0 +1,267 @@
+package matrix
+
+import (
+	"math"
+	"sort"
+	"unsafe"
+)
+
+// The kind of value index built by Index
+type IndexKind int
+
+const (
+	/*
+		A hash map from each value to its positions. Accelerates exact Search and Count.
+	*/
+	HashIndex IndexKind = iota
+
+	/*
+		Every element sorted by value. Also accelerates SearchRange, ArgMin, ArgMax,
+		Smallest, Largest and Percentile at the cost of slower writes. Every write that
+		changes a value is O(n), the entry is removed from and inserted into a slice,
+		moving on average half of the entries each time.
+	*/
+	OrderedIndex
+)
+
+// An element of the ordered index
+type indexEntry[T Element] struct {
+	value    T
+	position [2]uint
+}
+
+/*
+Compare two values with NaN ordered after every other value, so a NaN in the matrix
+cannot break the sort order of the ordered index.
+*/
+func valueLess[T Element](a, b T) bool {
+	if a != a {
+		return false
+	}
+	if b != b {
+		return true
+	}
+	return a < b
+}
+
+// Compare two entries by value then by position in row major order
+func entryLess[T Element](a, b indexEntry[T]) bool {
+	if valueLess(a.value, b.value) {
+		return true
+	}
+	if valueLess(b.value, a.value) {
+		return false
+	}
+	return positionLess(a.position, b.position)
+}
+
+// Build the ordered index from the current data
+func (m *Matrix[T]) buildOrderedIndex() {
+	m.ordered = m.collectEntries()
+}
+
+// Every element of the matrix sorted by value then position
+func (m *Matrix[T]) collectEntries() []indexEntry[T] {
+	entries := make([]indexEntry[T], 0, m.Size())
+
+	for i := uint(0); i < m.rows; i++ {
+		for j := uint(0); j < m.columns; j++ {
+			entries = append(entries, indexEntry[T]{value: m.reader.Read(i, j), position: [2]uint{i, j}})
+		}
+	}
+
+	sort.Slice(entries, func(a, b int) bool {
+		return entryLess(entries[a], entries[b])
+	})
+
+	return entries
+}
+
+/*
+The elements sorted by value, taken from the ordered index when there is one. The
+returned slice must not be modified.
+*/
+func (m *Matrix[T]) sortedEntries() []indexEntry[T] {
+	if m.ordered != nil {
+		return m.ordered
+	}
+	return m.collectEntries()
+}
+
+// Insert an entry keeping the ordered index sorted
+func (m *Matrix[T]) orderedAdd(value T, i, j uint) {
+	entry := indexEntry[T]{value: value, position: [2]uint{i, j}}
+
+	h := sort.Search(len(m.ordered), func(k int) bool {
+		return !entryLess(m.ordered[k], entry)
+	})
+
+	m.ordered = append(m.ordered, indexEntry[T]{})
+	copy(m.ordered[h+1:], m.ordered[h:])
+	m.ordered[h] = entry
+}
+
+// Remove an entry from the ordered index
+func (m *Matrix[T]) orderedRemove(value T, i, j uint) {
+	entry := indexEntry[T]{value: value, position: [2]uint{i, j}}
+
+	h := sort.Search(len(m.ordered), func(k int) bool {
+		return !entryLess(m.ordered[k], entry)
+	})
+
+	if h == len(m.ordered) || m.ordered[h].position != entry.position {
+		return
+	}
+
+	m.ordered = append(m.ordered[:h], m.ordered[h+1:]...)
+}
+
+// The number of entries in a sorted slice that are not NaN
+func comparableCount[T Element](entries []indexEntry[T]) int {
+	return sort.Search(len(entries), func(k int) bool {
+		return entries[k].value != entries[k].value
+	})
+}
+
+// The number of entries in the ordered index that are not NaN
+func (m *Matrix[T]) orderedCount() int {
+	return comparableCount(m.ordered)
+}
+
+// The first entry in the ordered index with a value not less than v
+func (m *Matrix[T]) orderedLowerBound(v T) int {
+	return sort.Search(len(m.ordered), func(k int) bool {
+		return !valueLess(m.ordered[k].value, v)
+	})
+}
+
+// The first entry in the ordered index with a value greater than v
+func (m *Matrix[T]) orderedUpperBound(v T) int {
+	return sort.Search(len(m.ordered), func(k int) bool {
+		return valueLess(v, m.ordered[k].value)
+	})
+}
+
+// The locations of the entries between lo and hi inclusive in row major order
+func (m *Matrix[T]) orderedRange(lo, hi T) []Location[T] {
+	if lo != lo || hi != hi {
+		return nil
+	}
+
+	start, end := m.orderedLowerBound(lo), m.orderedUpperBound(hi)
+	if start >= end {
+		return nil
+	}
+
+	found := make([]Location[T], 0, end-start)
+	for _, e := range m.ordered[start:end] {
+		found = append(found, Location[T]{position: e.position, value: e.value})
+	}
+
+	sortLocations(found)
+	return found
+}
+
+/*
+Return the k smallest elements in ascending order, equal elements in row major order.
+NaN is ignored. Takes O(k) time with an ordered index, otherwise the matrix is sorted.
+*/
+func (m Matrix[T]) Smallest(k uint) []Location[T] {
+	entries := m.sortedEntries()
+	n := comparableCount(entries)
+	if k > uint(n) {
+		k = uint(n)
+	}
+
+	found := make([]Location[T], 0, k)
+	for _, e := range entries[:k] {
+		found = append(found, Location[T]{position: e.position, value: e.value})
+	}
+
+	return found
+}
+
+/*
+Return the k largest elements in descending order, equal elements in row major order.
+NaN is ignored. Takes O(k) time with an ordered index, otherwise the matrix is sorted.
+*/
+func (m Matrix[T]) Largest(k uint) []Location[T] {
+	entries := m.sortedEntries()
+	end := comparableCount(entries)
+
+	found := make([]Location[T], 0, min(k, uint(end)))
+
+	// Walk runs of equal values from the largest down, each run is already in row major order
+	for end > 0 && uint(len(found)) < k {
+		v := entries[end-1].value
+		start := sort.Search(end, func(h int) bool {
+			return !valueLess(entries[h].value, v)
+		})
+
+		for _, e := range entries[start:end] {
+			if uint(len(found)) == k {
+				break
+			}
+			found = append(found, Location[T]{position: e.position, value: e.value})
+		}
+
+		end = start
+	}
+
+	return found
+}
+
+/*
+Return the pth percentile of the elements using the nearest rank method, so the result
+is always an element of the matrix. p must be between 0 and 100. NaN is ignored unless
+every element is NaN. Takes O(log n) time with an ordered index, a binary search past any
+NaN, otherwise the matrix is sorted.
+*/
+func (m Matrix[T]) Percentile(p float64) (T, error) {
+	if math.IsNaN(p) || p < 0 || p > 100 {
+		var zero T
+		return zero, ErrPercentileOutOfRange(p)
+	}
+
+	entries := m.sortedEntries()
+	n := comparableCount(entries)
+	if n == 0 {
+		return entries[0].value, nil
+	}
+
+	rank := int(math.Ceil(p / 100 * float64(n)))
+	if rank == 0 {
+		rank = 1
+	}
+
+	return entries[rank-1].value, nil
+}
+
+/*
+Return an estimate of the memory used by the index in bytes, or 0 if there is no index.
+A HashIndex costs roughly a map slot per distinct value plus 16 bytes per element, an
+OrderedIndex costs the size of T plus 16 bytes per element rounded up for alignment.
+*/
+func (m *Matrix[T]) IndexMemory() uint {
+	if m.ordered != nil {
+		return uint(cap(m.ordered)) * uint(unsafe.Sizeof(indexEntry[T]{}))
+	}
+
+	if m.index == nil {
+		return 0
+	}
+
+	var key T
+	var bucket [][2]uint
+
+	// Maps keep at most 7 of every 8 slots full, each slot has a control byte
+	slots := uint(len(m.index))*8/7 + 1
+	total := slots * uint(unsafe.Sizeof(key)+unsafe.Sizeof(bucket)+1)
+
+	for _, positions := range m.index {
+		total += uint(cap(positions)) * uint(unsafe.Sizeof([2]uint{}))
+	}
+	total += uint(cap(m.nans)) * uint(unsafe.Sizeof([2]uint{}))
+
+	return total
+}
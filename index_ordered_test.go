@@ -0,0 +1,226 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestOrderedIndex(t *testing.T) {
+	input := [][]int{
+		{5, 3, 8},
+		{1, 3, 9},
+		{7, 1, 5},
+	}
+
+	t.Run("it builds an ordered index when asked", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice(input)
+
+		err := m.Index(OrderedIndex)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !m.HasIndex() || m.index != nil || m.ordered == nil {
+			t.Error("expected only the ordered index to be built")
+		}
+
+		err = m.Index()
+		if !errors.Is(err, ErrIndexExists) {
+			t.Errorf("expected ErrIndexExists, got %v", err)
+		}
+	})
+
+	t.Run("it keeps the index kind on Reindex", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice(input)
+		m.Index(OrderedIndex)
+
+		m.Reindex()
+
+		if m.ordered == nil {
+			t.Error("expected the ordered index to be rebuilt")
+		}
+	})
+
+	t.Run("it answers range queries in row major order", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice(input)
+		m.Index(OrderedIndex)
+
+		got, found := m.SearchRange(3, 5)
+		if !found {
+			t.Fatal("expected found to be true, got false")
+		}
+
+		want := []Location[int]{
+			{position: [2]uint{0, 0}, value: 5},
+			{position: [2]uint{0, 1}, value: 3},
+			{position: [2]uint{1, 1}, value: 3},
+			{position: [2]uint{2, 2}, value: 5},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("it returns the k smallest and largest elements", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice(input)
+		m.Index(OrderedIndex)
+
+		smallest := m.Smallest(3)
+		want := []Location[int]{
+			{position: [2]uint{1, 0}, value: 1},
+			{position: [2]uint{2, 1}, value: 1},
+			{position: [2]uint{0, 1}, value: 3},
+		}
+		if !reflect.DeepEqual(smallest, want) {
+			t.Errorf("expected %v, got %v", want, smallest)
+		}
+
+		largest := m.Largest(4)
+		want = []Location[int]{
+			{position: [2]uint{1, 2}, value: 9},
+			{position: [2]uint{0, 2}, value: 8},
+			{position: [2]uint{2, 0}, value: 7},
+			{position: [2]uint{0, 0}, value: 5},
+		}
+		if !reflect.DeepEqual(largest, want) {
+			t.Errorf("expected %v, got %v", want, largest)
+		}
+
+		if len(m.Largest(20)) != 9 {
+			t.Error("expected k to be limited to the number of elements")
+		}
+	})
+
+	t.Run("it returns percentiles using the nearest rank", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice(input)
+		m.Index(OrderedIndex)
+
+		cases := map[float64]int{0: 1, 25: 3, 50: 5, 90: 9, 100: 9}
+		for p, want := range cases {
+			got, err := m.Percentile(p)
+			if err != nil || got != want {
+				t.Errorf("percentile %v: expected %d, got %d %v", p, want, got, err)
+			}
+		}
+
+		_, err := m.Percentile(101)
+		if err == nil {
+			t.Error("expected error but got none")
+		}
+	})
+
+	t.Run("it orders NaN after every other value", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice([][]float64{
+			{math.NaN(), 2, -1},
+			{4, math.NaN(), 2},
+		})
+		m.Index(OrderedIndex)
+
+		max, _ := m.ArgMax()
+		if max.Value() != 4 {
+			t.Errorf("expected a maximum of 4, got %v", max.Value())
+		}
+
+		if got := m.Largest(10); len(got) != 4 {
+			t.Errorf("expected 4 elements that are not NaN, got %v", got)
+		}
+
+		if got, _ := m.Percentile(100); got != 4 {
+			t.Errorf("expected the 100th percentile to be 4, got %v", got)
+		}
+
+		m.Set(0, 0, 3)
+		if got := m.Count(3); got != 1 {
+			t.Errorf("expected 1 three after replacing a NaN, got %d", got)
+		}
+	})
+
+	t.Run("queries match an unindexed matrix after random mutations", func(t *testing.T) {
+		r := rand.New(rand.NewSource(7))
+
+		m, _ := NewEmptyMatrix[int](5, 4)
+		m.Index(OrderedIndex)
+
+		other, _ := NewEmptyMatrix[int](5, 4)
+
+		for step := 0; step < 300; step++ {
+			switch r.Intn(4) {
+			case 0, 1:
+				m.Set(uint(r.Intn(5)), uint(r.Intn(4)), r.Intn(10)-3)
+			case 2:
+				other.Set(uint(r.Intn(5)), uint(r.Intn(4)), r.Intn(3))
+				m.AddInPlace(other)
+			case 3:
+				m.ScalarMultiplyInPlace(r.Intn(3) - 1)
+			}
+
+			assertOrderedIndexMatchesScan(t, m, r.Intn(10)-3, r.Intn(10)-3)
+			if t.Failed() {
+				t.Fatalf("ordered index diverged from data after step %d", step)
+			}
+		}
+	})
+}
+
+func TestIndexMemory(t *testing.T) {
+	m, _ := NewEmptyMatrix[int64](10, 10)
+
+	if m.IndexMemory() != 0 {
+		t.Errorf("expected 0 without an index, got %d", m.IndexMemory())
+	}
+
+	m.Index(OrderedIndex)
+	if got := m.IndexMemory(); got != 100*24 {
+		t.Errorf("expected %d bytes for the ordered index, got %d", 100*24, got)
+	}
+
+	m.DropIndex()
+	m.Index(HashIndex)
+	if got := m.IndexMemory(); got < 100*16 {
+		t.Errorf("expected at least %d bytes for the hash index, got %d", 100*16, got)
+	}
+}
+
+// Check that the queries answered by the ordered index match those of a plain scan
+func assertOrderedIndexMatchesScan(t *testing.T, m *Matrix[int], lo, hi int) {
+	t.Helper()
+
+	scan := &Matrix[int]{
+		rows:    m.rows,
+		columns: m.columns,
+		reader:  m.reader,
+	}
+
+	assertIndexMatchesScan(t, m)
+
+	got, _ := m.SearchRange(lo, hi)
+	want, _ := scan.SearchRange(lo, hi)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("range %d to %d: index returned %v, scan returned %v", lo, hi, got, want)
+	}
+
+	if m.Count(lo) != scan.Count(lo) {
+		t.Errorf("count of %d: index returned %d, scan returned %d", lo, m.Count(lo), scan.Count(lo))
+	}
+
+	gotMin, _ := m.ArgMin()
+	wantMin, _ := scan.ArgMin()
+	gotMax, _ := m.ArgMax()
+	wantMax, _ := scan.ArgMax()
+	if gotMin != wantMin || gotMax != wantMax {
+		t.Errorf("index returned min %v max %v, scan returned min %v max %v", gotMin, gotMax, wantMin, wantMax)
+	}
+
+	if !reflect.DeepEqual(m.Largest(3), scan.Largest(3)) {
+		t.Errorf("largest: index returned %v, scan returned %v", m.Largest(3), scan.Largest(3))
+	}
+
+	gotP, _ := m.Percentile(40)
+	wantP, _ := scan.Percentile(40)
+	if gotP != wantP {
+		t.Errorf("percentile: index returned %d, scan returned %d", gotP, wantP)
+	}
+}
@@ -0,0 +1,206 @@
+package matrix
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestDropIndex(t *testing.T) {
+	t.Run("it removes the index from the matrix", func(t *testing.T) {
+		m, _ := NewEmptyMatrix[int](3, 3)
+		m.Index()
+		m.DropIndex()
+
+		if m.HasIndex() {
+			t.Error("expected HasIndex to return false, got true")
+		}
+	})
+}
+
+func TestReindex(t *testing.T) {
+	t.Run("it rebuilds the index from the current data", func(t *testing.T) {
+		input := [][]int{
+			{1, 2},
+			{3, 4},
+		}
+		m, _ := NewMatrixFromSlice(input)
+		m.Index()
+
+		// Modify the backing slice without going through the matrix
+		input[0][0] = 4
+
+		err := m.Reindex()
+		if err != nil {
+			t.Error("got error but expected none")
+		}
+
+		want := map[int][][2]uint{
+			2: {{0, 1}},
+			3: {{1, 0}},
+			4: {{0, 0}, {1, 1}},
+		}
+
+		if !reflect.DeepEqual(want, m.index) {
+			t.Errorf("want %v, got %v", want, m.index)
+		}
+	})
+
+	t.Run("it builds an index when there is none", func(t *testing.T) {
+		m, _ := NewEmptyMatrix[int](2, 2)
+
+		err := m.Reindex()
+		if err != nil {
+			t.Error("got error but expected none")
+		}
+
+		if !m.HasIndex() {
+			t.Error("expected HasIndex to return true, got false")
+		}
+	})
+}
+
+func TestIndexMaintenance(t *testing.T) {
+	t.Run("it moves positions between buckets on Set", func(t *testing.T) {
+		input := [][]int{
+			{1, 2},
+			{2, 1},
+		}
+		m, _ := NewMatrixFromSlice(input)
+		m.Index()
+
+		m.Set(0, 1, 1)
+		m.Set(1, 0, 3)
+
+		want := map[int][][2]uint{
+			1: {{0, 0}, {0, 1}, {1, 1}},
+			3: {{1, 0}},
+		}
+
+		if !reflect.DeepEqual(want, m.index) {
+			t.Errorf("want %v, got %v", want, m.index)
+		}
+
+		_, found := m.Search(2)
+		if found {
+			t.Error("expected found to be false, got true")
+		}
+	})
+
+	t.Run("it keeps NaN positions in sync on Set", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice([][]float64{
+			{math.NaN(), 1},
+			{2, math.NaN()},
+		})
+		m.Index()
+
+		m.Set(0, 0, 2)
+		m.Set(1, 0, math.NaN())
+		m.Set(1, 0, math.NaN())
+
+		isNaN := func(v float64) bool { return v != v }
+
+		got, _ := m.SearchFunc(isNaN)
+		if len(got) != 2 || got[0].position != [2]uint{1, 0} || got[1].position != [2]uint{1, 1} {
+			t.Errorf("expected NaN at [1 0] and [1 1], got %v", got)
+		}
+
+		if len(m.index) != 2 {
+			t.Errorf("expected only the buckets for 1 and 2, got %v", m.index)
+		}
+	})
+
+	t.Run("it updates the index for every in place operation", func(t *testing.T) {
+		input := [][]int{
+			{1, 2, 3},
+			{4, 5, 6},
+		}
+		m, _ := NewMatrixFromSlice(input)
+		m.Index()
+
+		other, _ := NewMatrixFromSlice([][]int{
+			{2, 0, 1},
+			{1, 3, 0},
+		})
+
+		m.AddInPlace(other)
+		assertIndexMatchesScan(t, m)
+
+		m.SubtractInPlace(other)
+		assertIndexMatchesScan(t, m)
+
+		m.ScalarMultiplyInPlace(2)
+		assertIndexMatchesScan(t, m)
+
+		m.HadamardProductInPlace(other)
+		assertIndexMatchesScan(t, m)
+
+		m.Fill(7)
+		assertIndexMatchesScan(t, m)
+
+		m.Zero()
+		assertIndexMatchesScan(t, m)
+	})
+
+	t.Run("search results match a full scan after random mutations", func(t *testing.T) {
+		r := rand.New(rand.NewSource(42))
+
+		m, _ := NewEmptyMatrix[int](6, 5)
+		m.Index()
+
+		other, _ := NewEmptyMatrix[int](6, 5)
+
+		for step := 0; step < 500; step++ {
+			switch r.Intn(6) {
+			case 0, 1:
+				m.Set(uint(r.Intn(6)), uint(r.Intn(5)), r.Intn(8))
+			case 2:
+				other.Set(uint(r.Intn(6)), uint(r.Intn(5)), r.Intn(3))
+				m.AddInPlace(other)
+			case 3:
+				m.SubtractInPlace(other)
+			case 4:
+				m.HadamardProductInPlace(other)
+			case 5:
+				m.ScalarMultiplyInPlace(r.Intn(3) - 1)
+			}
+
+			assertIndexMatchesScan(t, m)
+			if t.Failed() {
+				t.Fatalf("index diverged from data after step %d", step)
+			}
+		}
+	})
+}
+
+/*
+Check that an indexed Search returns the same locations as a scan of the data for
+every value present in the matrix and every value in the index.
+*/
+func assertIndexMatchesScan[T Element](t *testing.T, m *Matrix[T]) {
+	t.Helper()
+
+	scan := &Matrix[T]{
+		rows:    m.rows,
+		columns: m.columns,
+		reader:  m.reader,
+	}
+
+	values := make(map[T]struct{})
+	for _, v := range m.Flatten() {
+		values[v] = struct{}{}
+	}
+	for v := range m.index {
+		values[v] = struct{}{}
+	}
+
+	for v := range values {
+		got, gotFound := m.Search(v)
+		want, wantFound := scan.Search(v)
+
+		if gotFound != wantFound || !reflect.DeepEqual(got, want) {
+			t.Errorf("search for %v: index returned %v, scan returned %v", v, got, want)
+		}
+	}
+}
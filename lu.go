@@ -0,0 +1,279 @@
+package matrix
+
+import "math/big"
+
+/*
+The LU decomposition of a square matrix with partial pivoting, PA = LU. The lower
+and upper triangular factors are packed into a single slice, the unit diagonal of
+L is implied.
+*/
+type LU[T Float] struct {
+	size     uint
+	lu       [][]T
+	pivot    []uint
+	sign     int
+	singular bool
+}
+
+/*
+Factor a square matrix into lower and upper triangular matrixes. A singular matrix
+can still be factored, but Solve and Inverse will return ErrSingularMatrix. The matrix
+is treated as singular when a pivot is no larger than n * epsilon * the largest pivot.
+*/
+func NewLU[T Float](m *Matrix[T]) (*LU[T], error) {
+	if m.rows != m.columns {
+		return nil, ErrMatrixMustBeSquare
+	}
+
+	n := m.rows
+
+	lu := make([][]T, n)
+	for i := uint(0); i < n; i++ {
+		lu[i] = make([]T, n)
+		for j := uint(0); j < n; j++ {
+			lu[i][j] = m.reader.Read(i, j)
+		}
+	}
+
+	pivot := make([]uint, n)
+	for i := range pivot {
+		pivot[i] = uint(i)
+	}
+
+	sign := 1
+
+	for k := uint(0); k < n; k++ {
+		// Choose the row with the largest magnitude in column k as the pivot
+		p := k
+		for i := k + 1; i < n; i++ {
+			if abs(lu[i][k]) > abs(lu[p][k]) {
+				p = i
+			}
+		}
+
+		if p != k {
+			lu[p], lu[k] = lu[k], lu[p]
+			pivot[p], pivot[k] = pivot[k], pivot[p]
+			sign = -sign
+		}
+
+		if lu[k][k] == 0 {
+			continue
+		}
+
+		for i := k + 1; i < n; i++ {
+			lu[i][k] /= lu[k][k]
+			for j := k + 1; j < n; j++ {
+				lu[i][j] -= lu[i][k] * lu[k][j]
+			}
+		}
+	}
+
+	// A pivot that is tiny next to the largest is rounding error left from a zero
+	var largest T
+	for k := uint(0); k < n; k++ {
+		largest = max(largest, abs(lu[k][k]))
+	}
+
+	tolerance := T(n) * machineEpsilon[T]() * largest
+	singular := false
+
+	for k := uint(0); k < n; k++ {
+		if abs(lu[k][k]) <= tolerance {
+			singular = true
+		}
+	}
+
+	return &LU[T]{
+		size:     n,
+		lu:       lu,
+		pivot:    pivot,
+		sign:     sign,
+		singular: singular,
+	}, nil
+}
+
+func (f *LU[T]) IsSingular() bool {
+	return f.singular
+}
+
+// Return the lower triangular factor with a unit diagonal
+func (f *LU[T]) L() *Matrix[T] {
+	l, _ := NewEmptyMatrix[T](f.size, f.size)
+
+	for i := uint(0); i < f.size; i++ {
+		for j := uint(0); j < i; j++ {
+			l.writer.Write(i, j, f.lu[i][j])
+		}
+		l.writer.Write(i, i, 1)
+	}
+
+	return l
+}
+
+// Return the upper triangular factor
+func (f *LU[T]) U() *Matrix[T] {
+	u, _ := NewEmptyMatrix[T](f.size, f.size)
+
+	for i := uint(0); i < f.size; i++ {
+		for j := i; j < f.size; j++ {
+			u.writer.Write(i, j, f.lu[i][j])
+		}
+	}
+
+	return u
+}
+
+/*
+Return the row permutation applied during factoring. Row i of PA is row Pivot()[i]
+of the original matrix.
+*/
+func (f *LU[T]) Pivot() []uint {
+	pivot := make([]uint, len(f.pivot))
+	copy(pivot, f.pivot)
+	return pivot
+}
+
+/*
+Return the determinant as the signed product of the pivots. It is not rounded to zero
+when the matrix is treated as singular, check IsSingular for that.
+*/
+func (f *LU[T]) Determinant() T {
+	det := T(f.sign)
+	for i := uint(0); i < f.size; i++ {
+		det *= f.lu[i][i]
+	}
+	return det
+}
+
+/*
+Solve AX = B for X where A is the decomposed matrix. B may have any number of columns,
+each column is solved as a separate system.
+*/
+func (f *LU[T]) Solve(b *Matrix[T]) (*Matrix[T], error) {
+	if b.rows != f.size {
+		return nil, ErrSolveRowMismatch
+	}
+
+	if f.singular {
+		return nil, ErrSingularMatrix
+	}
+
+	n := f.size
+
+	x, err := NewEmptyMatrix[T](n, b.columns)
+	if err != nil {
+		return nil, err
+	}
+
+	y := make([]T, n)
+
+	for c := uint(0); c < b.columns; c++ {
+		// Forward substitution with the permuted right hand side, Ly = Pb
+		for i := uint(0); i < n; i++ {
+			sum := b.reader.Read(f.pivot[i], c)
+			for j := uint(0); j < i; j++ {
+				sum -= f.lu[i][j] * y[j]
+			}
+			y[i] = sum
+		}
+
+		// Back substitution, Ux = y
+		for i := n; i > 0; i-- {
+			r := i - 1
+			sum := y[r]
+			for j := r + 1; j < n; j++ {
+				sum -= f.lu[r][j] * y[j]
+			}
+			y[r] = sum / f.lu[r][r]
+		}
+
+		for i := uint(0); i < n; i++ {
+			x.writer.Write(i, c, y[i])
+		}
+	}
+
+	return x, nil
+}
+
+func (f *LU[T]) Inverse() (*Matrix[T], error) {
+	identity, err := NewIdentityMatrix[T](f.size)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.Solve(identity)
+}
+
+/*
+Compute the determinant of an integer matrix exactly using fraction free Bareiss
+elimination. Intermediate values are held as big integers so the result is exact for
+any size of input, including determinants that do not fit in T.
+*/
+func BareissDeterminant[T Integer](m *Matrix[T]) (*big.Int, error) {
+	if m.rows != m.columns {
+		return nil, ErrMatrixMustBeSquare
+	}
+
+	n := m.rows
+
+	a := make([][]*big.Int, n)
+	for i := uint(0); i < n; i++ {
+		a[i] = make([]*big.Int, n)
+		for j := uint(0); j < n; j++ {
+			a[i][j] = toBigInt(m.reader.Read(i, j))
+		}
+	}
+
+	sign := 1
+	prev := big.NewInt(1)
+	t := new(big.Int)
+
+	for k := uint(0); k+1 < n; k++ {
+		if a[k][k].Sign() == 0 {
+			p := k + 1
+			for p < n && a[p][k].Sign() == 0 {
+				p++
+			}
+			if p == n {
+				return big.NewInt(0), nil
+			}
+			a[p], a[k] = a[k], a[p]
+			sign = -sign
+		}
+
+		for i := k + 1; i < n; i++ {
+			for j := k + 1; j < n; j++ {
+				// a[i][j] = (a[i][j]*a[k][k] - a[i][k]*a[k][j]) / prev, the division is exact
+				a[i][j].Mul(a[i][j], a[k][k])
+				t.Mul(a[i][k], a[k][j])
+				a[i][j].Sub(a[i][j], t)
+				a[i][j].Quo(a[i][j], prev)
+			}
+		}
+
+		prev = a[k][k]
+	}
+
+	det := new(big.Int).Set(a[n-1][n-1])
+	if sign < 0 {
+		det.Neg(det)
+	}
+
+	return det, nil
+}
+
+func toBigInt[T Integer](v T) *big.Int {
+	// The complement of zero is only positive for unsigned types
+	if ^T(0) > 0 {
+		return new(big.Int).SetUint64(uint64(v))
+	}
+	return big.NewInt(int64(v))
+}
+
+func abs[T Float](v T) T {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,231 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestNewLU(t *testing.T) {
+	t.Run("it factors the matrix so that PA = LU", func(t *testing.T) {
+		input := [][]float64{
+			{2, 1, 1},
+			{4, -6, 0},
+			{-2, 7, 2},
+		}
+		a, _ := NewMatrixFromSlice(input)
+
+		lu, err := NewLU(a)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		product, _ := lu.L().Multiply(lu.U())
+
+		pivot := lu.Pivot()
+		permuted := make([][]float64, len(pivot))
+		for i, p := range pivot {
+			permuted[i] = input[p]
+		}
+		want, _ := NewMatrixFromSlice(permuted)
+
+		matrixesAreClose(t, product, want, 1e-12)
+	})
+
+	t.Run("it returns an error if the matrix is not square", func(t *testing.T) {
+		a, _ := NewEmptyMatrix[float64](2, 3)
+
+		_, err := NewLU(a)
+		if !errors.Is(err, ErrMatrixMustBeSquare) {
+			t.Errorf("expected ErrMatrixMustBeSquare, got %v", err)
+		}
+	})
+}
+
+func TestLUDeterminant(t *testing.T) {
+	t.Run("it returns the determinant", func(t *testing.T) {
+		input := [][]float64{
+			{2, -3, 1},
+			{2, 0, -1},
+			{1, 4, 5},
+		}
+		a, _ := NewMatrixFromSlice(input)
+		lu, _ := NewLU(a)
+
+		got := lu.Determinant()
+		if math.Abs(got-49) > 1e-9 {
+			t.Errorf("expected 49, got %v", got)
+		}
+	})
+
+	t.Run("it returns zero for a singular matrix", func(t *testing.T) {
+		input := [][]float32{
+			{1, 2},
+			{2, 4},
+		}
+		a, _ := NewMatrixFromSlice(input)
+		lu, _ := NewLU(a)
+
+		if lu.Determinant() != 0 {
+			t.Errorf("expected 0, got %v", lu.Determinant())
+		}
+
+		if !lu.IsSingular() {
+			t.Error("expected IsSingular to return true, got false")
+		}
+	})
+}
+
+func TestLUSolve(t *testing.T) {
+	t.Run("it solves a linear system", func(t *testing.T) {
+		input := [][]float64{
+			{0, 2, 1},
+			{1, 1, 1},
+			{2, 1, 0},
+		}
+		a, _ := NewMatrixFromSlice(input)
+		b, _ := NewMatrixFromSlice([][]float64{{7}, {6}, {4}})
+		want, _ := NewMatrixFromSlice([][]float64{{1}, {2}, {3}})
+
+		lu, _ := NewLU(a)
+		x, err := lu.Solve(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		matrixesAreClose(t, x, want, 1e-12)
+	})
+
+	t.Run("it returns an error if the matrix is singular", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice([][]float64{{1, 2}, {2, 4}})
+		b, _ := NewEmptyMatrix[float64](2, 1)
+
+		lu, _ := NewLU(a)
+		_, err := lu.Solve(b)
+		if !errors.Is(err, ErrSingularMatrix) {
+			t.Errorf("expected ErrSingularMatrix, got %v", err)
+		}
+	})
+
+	t.Run("it returns an error if the row counts do not match", func(t *testing.T) {
+		a, _ := NewIdentityMatrix[float64](3)
+		b, _ := NewEmptyMatrix[float64](2, 1)
+
+		lu, _ := NewLU(a)
+		_, err := lu.Solve(b)
+		if !errors.Is(err, ErrSolveRowMismatch) {
+			t.Errorf("expected ErrSolveRowMismatch, got %v", err)
+		}
+	})
+}
+
+func TestLUInverse(t *testing.T) {
+	t.Run("it inverts the matrix", func(t *testing.T) {
+		input := [][]float64{
+			{4, 7},
+			{2, 6},
+		}
+		a, _ := NewMatrixFromSlice(input)
+		want, _ := NewMatrixFromSlice([][]float64{
+			{0.6, -0.7},
+			{-0.2, 0.4},
+		})
+
+		lu, _ := NewLU(a)
+		inverse, err := lu.Inverse()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		matrixesAreClose(t, inverse, want, 1e-12)
+
+		identity, _ := a.Multiply(inverse)
+		i, _ := NewIdentityMatrix[float64](2)
+		matrixesAreClose(t, identity, i, 1e-12)
+	})
+
+	t.Run("it returns the exact determinant of a badly scaled matrix", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice([][]float64{
+			{1e17, 0},
+			{0, 1},
+		})
+
+		lu, _ := NewLU(a)
+		if got := lu.Determinant(); got != 1e17 {
+			t.Errorf("expected 1e17, got %v", got)
+		}
+	})
+
+	t.Run("it returns an error for a matrix that is singular up to rounding", func(t *testing.T) {
+		// Elimination leaves a last pivot of about 1e-16 rather than exactly zero
+		a, _ := NewMatrixFromSlice([][]float64{
+			{1, 2, 3},
+			{4, 5, 6},
+			{7, 8, 9},
+		})
+
+		lu, _ := NewLU(a)
+		if !lu.IsSingular() {
+			t.Error("expected IsSingular to return true, got false")
+		}
+
+		if got := lu.Determinant(); math.Abs(got) > 1e-12 {
+			t.Errorf("expected a determinant near 0, got %v", got)
+		}
+
+		_, err := lu.Inverse()
+		if !errors.Is(err, ErrSingularMatrix) {
+			t.Errorf("expected ErrSingularMatrix, got %v", err)
+		}
+	})
+}
+
+func TestBareissDeterminant(t *testing.T) {
+	cases := []struct {
+		name  string
+		input [][]int
+		want  int64
+	}{
+		{name: "1x1", input: [][]int{{-7}}, want: -7},
+		{name: "3x3", input: [][]int{{2, -3, 1}, {2, 0, -1}, {1, 4, 5}}, want: 49},
+		{name: "requires pivoting", input: [][]int{{0, 1}, {1, 0}}, want: -1},
+		{name: "singular", input: [][]int{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}, want: 0},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			m, _ := NewMatrixFromSlice(test.input)
+
+			got, err := BareissDeterminant(m)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got.Cmp(big.NewInt(test.want)) != 0 {
+				t.Errorf("expected %d, got %s", test.want, got)
+			}
+		})
+	}
+
+	t.Run("it does not overflow narrow unsigned types", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice([][]uint8{
+			{255, 0},
+			{0, 255},
+		})
+
+		got, _ := BareissDeterminant(m)
+		if got.Cmp(big.NewInt(65025)) != 0 {
+			t.Errorf("expected 65025, got %s", got)
+		}
+	})
+
+	t.Run("it returns an error if the matrix is not square", func(t *testing.T) {
+		m, _ := NewEmptyMatrix[int](2, 3)
+
+		_, err := BareissDeterminant(m)
+		if !errors.Is(err, ErrMatrixMustBeSquare) {
+			t.Errorf("expected ErrMatrixMustBeSquare, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,99 @@
+package matrix
+
+/*
+Apply fn to every element creating a new matrix with the results, for example to clamp
+or take the absolute value of each element
+*/
+func (m Matrix[T]) Map(fn func(T) T) (*Matrix[T], error) {
+	return m.MapIndexed(func(i, j uint, v T) T {
+		return fn(v)
+	})
+}
+
+/*
+Apply fn to every element and its 0 indexed position creating a new matrix with the
+results
+*/
+func (m Matrix[T]) MapIndexed(fn func(i, j uint, v T) T) (*Matrix[T], error) {
+	result, err := NewEmptyMatrix[T](m.rows, m.columns)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := uint(0); i < m.rows; i++ {
+		for j := uint(0); j < m.columns; j++ {
+			result.writer.Write(i, j, fn(i, j, m.reader.Read(i, j)))
+		}
+	}
+
+	return result, nil
+}
+
+/*
+Combine the elements at the same position of two matrixes of the same dimensions with fn
+creating a new matrix with the results
+*/
+func (m Matrix[T]) ZipWith(a *Matrix[T], fn func(x, y T) T) (*Matrix[T], error) {
+	if !AreSameDimensions(&m, a) {
+		return nil, ErrMustBeSameDimensions
+	}
+
+	return m.MapIndexed(func(i, j uint, v T) T {
+		return fn(v, a.reader.Read(i, j))
+	})
+}
+
+/*
+Replace every element of the original matrix with the result of fn. The index is kept
+in sync.
+*/
+func (m *Matrix[T]) ApplyInPlace(fn func(T) T) (*Matrix[T], error) {
+	return m.applyIndexedInPlace(func(i, j uint, v T) T {
+		return fn(v)
+	})
+}
+
+func (m *Matrix[T]) applyIndexedInPlace(fn func(i, j uint, v T) T) (*Matrix[T], error) {
+	if err := m.writable(); err != nil {
+		return nil, err
+	}
+
+	for i := uint(0); i < m.rows; i++ {
+		for j := uint(0); j < m.columns; j++ {
+			m.write(i, j, fn(i, j, m.reader.Read(i, j)))
+		}
+	}
+
+	return m, nil
+}
+
+// Combine the elements of a into the original matrix with fn
+func (m *Matrix[T]) zipWithInPlace(a *Matrix[T], fn func(x, y T) T) (*Matrix[T], error) {
+	if !AreSameDimensions(m, a) {
+		return nil, ErrMustBeSameDimensions
+	}
+
+	return m.applyIndexedInPlace(func(i, j uint, v T) T {
+		return fn(v, a.reader.Read(i, j))
+	})
+}
+
+/*
+Convert a matrix to element type U using Go conversion rules, so floats are truncated
+toward zero when converted to an integer type and integers out of range of U wrap. The
+source type is inferred, for example Convert[float64](m). The index is not copied.
+*/
+func Convert[U, T Element](m *Matrix[T]) (*Matrix[U], error) {
+	result, err := NewEmptyMatrix[U](m.rows, m.columns)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := uint(0); i < m.rows; i++ {
+		for j := uint(0); j < m.columns; j++ {
+			result.writer.Write(i, j, U(m.reader.Read(i, j)))
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,122 @@
+package matrix
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	t.Run("it applies the function to every element", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice([][]int{
+			{-3, 5},
+			{12, 0},
+		})
+
+		clamp := func(v int) int {
+			return max(0, min(v, 10))
+		}
+
+		got, err := m.Map(clamp)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := NewMatrixFromSlice([][]int{
+			{0, 5},
+			{10, 0},
+		})
+		matrixesAreEquivalent(t, got, want)
+	})
+
+	t.Run("it passes the position of each element", func(t *testing.T) {
+		m, _ := NewEmptyMatrix[uint](2, 3)
+
+		got, _ := m.MapIndexed(func(i, j uint, v uint) uint {
+			return i*10 + j
+		})
+
+		want, _ := NewMatrixFromSlice([][]uint{
+			{0, 1, 2},
+			{10, 11, 12},
+		})
+		matrixesAreEquivalent(t, got, want)
+	})
+}
+
+func TestZipWith(t *testing.T) {
+	t.Run("it combines elements at the same position", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice([][]float64{{1, 8}, {3, 2}})
+		b, _ := NewMatrixFromSlice([][]float64{{4, 5}, {3, 9}})
+
+		got, err := a.ZipWith(b, func(x, y float64) float64 { return max(x, y) })
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := NewMatrixFromSlice([][]float64{{4, 8}, {3, 9}})
+		matrixesAreEquivalent(t, got, want)
+	})
+
+	t.Run("it returns an error if the matrixes have different dimensions", func(t *testing.T) {
+		a, _ := NewEmptyMatrix[int](2, 2)
+		b, _ := NewEmptyMatrix[int](2, 1)
+
+		_, err := a.ZipWith(b, func(x, y int) int { return x + y })
+		if !errors.Is(err, ErrMustBeSameDimensions) {
+			t.Errorf("expected ErrMustBeSameDimensions, got %v", err)
+		}
+	})
+}
+
+func TestApplyInPlace(t *testing.T) {
+	t.Run("it replaces every element and keeps the index in sync", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice([][]int{
+			{1, -2},
+			{-3, 4},
+		})
+		m.Index()
+
+		abs := func(v int) int {
+			if v < 0 {
+				return -v
+			}
+			return v
+		}
+
+		_, err := m.ApplyInPlace(abs)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := NewMatrixFromSlice([][]int{
+			{1, 2},
+			{3, 4},
+		})
+		want.Index()
+		matrixesAreEqual(t, m, want)
+		assertIndexMatchesScan(t, m)
+	})
+}
+
+func TestConvert(t *testing.T) {
+	t.Run("it converts integers to floats", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice([][]int{{1, -2}, {3, 4}})
+
+		got, err := Convert[float64](m)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := NewMatrixFromSlice([][]float64{{1, -2}, {3, 4}})
+		matrixesAreEquivalent(t, got, want)
+	})
+
+	t.Run("it truncates floats toward zero", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice([][]float32{{1.9, -1.9}})
+
+		got, _ := Convert[int8](m)
+
+		want, _ := NewMatrixFromSlice([][]int8{{1, -1}})
+		matrixesAreEquivalent(t, got, want)
+	})
+}
@@ -8,6 +8,16 @@ type Element interface {
 	int | int8 | int16 | int32 | int64 | uint | uint8 | uint16 | uint32 | uint64 | float32 | float64
 }
 
+// The integer members of Element
+type Integer interface {
+	int | int8 | int16 | int32 | int64 | uint | uint8 | uint16 | uint32 | uint64
+}
+
+// The floating point members of Element
+type Float interface {
+	float32 | float64
+}
+
 type DefaultDataStore[T Element] struct {
 	data [][]T
 }
@@ -57,13 +67,19 @@ func (d *DefaultDataStore[T]) Write(i, j uint, value T) {
 	d.data[i][j] = value
 }
 
-// The index is an optional field to help speed lookup when needed
+/*
+The index is an optional field to help speed lookup when needed. The generation counts
+how many times the data store has been replaced so views can tell their parent changed.
+*/
 type Matrix[T Element] struct {
-	rows    uint
-	columns uint
-	index   map[T][][2]uint
-	reader  DataReader[T]
-	writer  DataWriter[T]
+	rows       uint
+	columns    uint
+	index      map[T][][2]uint
+	nans       [][2]uint
+	ordered    []indexEntry[T]
+	reader     DataReader[T]
+	writer     DataWriter[T]
+	generation uint
 }
 
 // The position field is the row, column coordinates 0 indexed
@@ -72,6 +88,21 @@ type Location[T Element] struct {
 	value    T
 }
 
+// The row of the location, 0 indexed
+func (l Location[T]) Row() uint {
+	return l.position[0]
+}
+
+// The column of the location, 0 indexed
+func (l Location[T]) Column() uint {
+	return l.position[1]
+}
+
+// The element found at the location
+func (l Location[T]) Value() T {
+	return l.value
+}
+
 func (m *Matrix[T]) Rows() uint {
 	return m.rows
 }
@@ -81,12 +112,31 @@ func (m *Matrix[T]) Columns() uint {
 }
 
 func (m *Matrix[T]) HasIndex() bool {
-	if m.index != nil {
+	if m.index != nil || m.ordered != nil {
 		return true
 	}
 	return false
 }
 
+/*
+A matrix is read only when its data store does not implement DataWriter. Mutating
+methods on a read only matrix return ErrReadOnly.
+*/
+func (m *Matrix[T]) IsReadOnly() bool {
+	return m.writer == nil
+}
+
+// Return the error a mutating method should fail with, or nil if the matrix can be written
+func (m *Matrix[T]) writable() error {
+	if isStaleView(m) {
+		return ErrStaleView
+	}
+	if m.IsReadOnly() {
+		return ErrReadOnly
+	}
+	return nil
+}
+
 func (m *Matrix[T]) Size() uint {
 	return m.rows * m.columns
 }
@@ -131,20 +181,19 @@ func NewMatrixFromSlice[T Element](data [][]T) (*Matrix[T], error) {
 }
 
 /*
-Create a new empty matrix with a given size
+Create a new empty matrix with a given size, backed by a single contiguous slice
 */
 func NewEmptyMatrix[T Element](rows, columns uint) (*Matrix[T], error) {
 	if rows == 0 || columns == 0 {
 		return nil, ErrRowColumSize
 	}
 
-	data := make([][]T, rows)
-	for i := range data {
-		data[i] = make([]T, columns)
+	store, err := NewDenseStore[T](rows, columns)
+	if err != nil {
+		return nil, err
 	}
 
-	store := &DefaultDataStore[T]{data: data}
-	return NewMatrix(store)
+	return NewMatrix[T](store)
 }
 
 func NewIdentityMatrix[T Element](size uint) (*Matrix[T], error) {
@@ -160,31 +209,3 @@ func NewIdentityMatrix[T Element](size uint) (*Matrix[T], error) {
 
 	return m, nil
 }
-
-/*
-Build an index of the matrix for cases where quicker lookup might be desired. Adds
-overhead for index storage and maintenance.
-*/
-func (m *Matrix[T]) Index() error {
-	if m.HasIndex() {
-		return ErrIndexExists
-	}
-
-	index := make(map[T][][2]uint)
-
-	for i := uint(0); i < m.rows; i++ {
-		for j := uint(0); j < m.columns; j++ {
-			element := m.reader.Read(i, j)
-
-			v, ok := index[element]
-			if ok {
-				index[element] = append(v, [2]uint{i, j})
-			} else {
-				index[element] = [][2]uint{{i, j}}
-			}
-		}
-	}
-
-	m.index = index
-	return nil
-}
@@ -1,6 +1,7 @@
 package matrix
 
 import (
+	"math"
 	"reflect"
 	"testing"
 )
@@ -150,7 +151,7 @@ func TestNewEmptyMatrix(t *testing.T) {
 		}
 		want, _ := NewMatrixFromSlice(input)
 
-		matrixesAreEqual(t, got, want)
+		matrixesAreEquivalent(t, got, want)
 	})
 
 	t.Run("it does not allow a zero element matrix", func(t *testing.T) {
@@ -174,7 +175,7 @@ func TestNewIdentityMatrix(t *testing.T) {
 		}
 		want, _ := NewMatrixFromSlice(input)
 
-		matrixesAreEqual(t, r, want)
+		matrixesAreEquivalent(t, r, want)
 	})
 }
 
@@ -197,6 +198,30 @@ func TestHasIndex(t *testing.T) {
 	})
 }
 
+func TestIsReadOnly(t *testing.T) {
+	t.Run("it returns true if the data store cannot be written to", func(t *testing.T) {
+		m, _ := NewMatrix[int](newReadOnlyStore([][]int{{1}}))
+		if !m.IsReadOnly() {
+			t.Error("expected IsReadOnly to return true, got false")
+		}
+	})
+
+	t.Run("it returns false if the data store can be written to", func(t *testing.T) {
+		m, _ := NewEmptyMatrix[int](1, 1)
+		if m.IsReadOnly() {
+			t.Error("expected IsReadOnly to return false, got true")
+		}
+	})
+
+	t.Run("views of a read only matrix are read only", func(t *testing.T) {
+		m, _ := NewMatrix[int](newReadOnlyStore([][]int{{1, 2}, {3, 4}}))
+		view, _ := m.Row(0)
+		if !view.IsReadOnly() {
+			t.Error("expected IsReadOnly to return true, got false")
+		}
+	})
+}
+
 func TestIndex(t *testing.T) {
 	t.Run("it creates an index for the matrix", func(t *testing.T) {
 		input := [][]int{
@@ -246,6 +271,27 @@ func TestIndex(t *testing.T) {
 	})
 }
 
+// A data store that implements DataReader but not DataWriter
+type ReadOnlyStore[T Element] struct {
+	store *DefaultDataStore[T]
+}
+
+func newReadOnlyStore[T Element](data [][]T) *ReadOnlyStore[T] {
+	return &ReadOnlyStore[T]{store: &DefaultDataStore[T]{data: data}}
+}
+
+func (r *ReadOnlyStore[T]) Read(i, j uint) T {
+	return r.store.Read(i, j)
+}
+
+func (r *ReadOnlyStore[T]) Shape() (uint, uint) {
+	return r.store.Shape()
+}
+
+func (r *ReadOnlyStore[T]) Validate() error {
+	return r.store.Validate()
+}
+
 func pointersAreSame[T any](a, b *T) bool {
 	if reflect.ValueOf(a).Pointer() == reflect.ValueOf(b).Pointer() {
 		return true
@@ -259,3 +305,48 @@ func matrixesAreEqual[T Element](t *testing.T, a, b *Matrix[T]) {
 		t.Errorf("%+v does not equal %+v", a, b)
 	}
 }
+
+/*
+Compare the shape, elements and index of two matrixes whose data stores may differ, a
+matrix built from a slice is equivalent to an empty matrix filled with the same values.
+*/
+func matrixesAreEquivalent[T Element](t *testing.T, a, b *Matrix[T]) {
+	t.Helper()
+	elementsAreEqual(t, a, b)
+	if !reflect.DeepEqual(a.index, b.index) {
+		t.Errorf("index %v does not equal %v", a.index, b.index)
+	}
+}
+
+func matrixesAreClose[T Float](t *testing.T, a, b *Matrix[T], tolerance float64) {
+	t.Helper()
+	if a.rows != b.rows || a.columns != b.columns {
+		t.Fatalf("%dx%d matrix does not match %dx%d matrix", a.rows, a.columns, b.rows, b.columns)
+	}
+
+	for i := uint(0); i < a.rows; i++ {
+		for j := uint(0); j < a.columns; j++ {
+			x, y := a.reader.Read(i, j), b.reader.Read(i, j)
+			if math.Abs(float64(x)-float64(y)) > tolerance {
+				t.Errorf("at position [%d %d] %v is not close to %v", i, j, x, y)
+			}
+		}
+	}
+}
+
+// Compare the shape and elements of two matrixes regardless of their data stores
+func elementsAreEqual[T Element](t *testing.T, a, b *Matrix[T]) {
+	t.Helper()
+	if a.rows != b.rows || a.columns != b.columns {
+		t.Fatalf("%dx%d matrix does not match %dx%d matrix", a.rows, a.columns, b.rows, b.columns)
+	}
+
+	for i := uint(0); i < a.rows; i++ {
+		for j := uint(0); j < a.columns; j++ {
+			x, y := a.reader.Read(i, j), b.reader.Read(i, j)
+			if x != y {
+				t.Errorf("at position [%d %d] %v does not equal %v", i, j, x, y)
+			}
+		}
+	}
+}
@@ -0,0 +1,291 @@
+package matrix
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// The header fields of a Matrix Market file
+type mtxHeader struct {
+	format   string
+	field    string
+	symmetry string
+}
+
+/*
+Read a matrix in Matrix Market format. The coordinate format is read into a CSR store
+and the array format into a dense store. Real, integer and pattern fields are supported,
+pattern entries are read as 1. Symmetric and skew-symmetric matrixes are expanded so the
+full matrix is stored.
+*/
+func ReadMatrixMarket[T Element](r io.Reader) (*Matrix[T], error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	line := 0
+
+	// Return the fields of the next line that is not blank or a comment
+	next := func() ([]string, error) {
+		for scanner.Scan() {
+			line++
+			text := strings.TrimSpace(scanner.Text())
+			if text == "" || strings.HasPrefix(text, "%") {
+				continue
+			}
+			return strings.Fields(text), nil
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	if !scanner.Scan() {
+		return nil, ErrMatrixMarket(1, "missing header")
+	}
+	line++
+
+	header, err := parseMTXHeader(scanner.Text())
+	if err != nil {
+		return nil, err
+	}
+
+	if kind, _ := elementKind[T](); header.symmetry == "skew-symmetric" && kind == 'u' {
+		return nil, ErrMatrixMarket(line, "skew-symmetric matrixes require a signed element type")
+	}
+
+	size, err := next()
+	if err != nil {
+		return nil, err
+	}
+
+	expected := 2
+	if header.format == "coordinate" {
+		expected = 3
+	}
+
+	dims, err := parseMTXUints(size, expected)
+	if err != nil {
+		return nil, ErrMatrixMarket(line, err.Error())
+	}
+
+	rows, columns := dims[0], dims[1]
+
+	if header.symmetry != "general" && rows != columns {
+		return nil, ErrMatrixMarket(line, fmt.Sprintf("%s matrix must be square", header.symmetry))
+	}
+
+	parse := func(fields []string) (T, error) {
+		if header.field == "pattern" {
+			return 1, nil
+		}
+		v, err := ParseElement[T](fields[0])
+		if err != nil {
+			return v, ErrMatrixMarket(line, fmt.Sprintf("invalid value %q", fields[0]))
+		}
+		return v, nil
+	}
+
+	if header.format == "coordinate" {
+		builder, err := NewCOOBuilder[T](rows, columns)
+		if err != nil {
+			return nil, err
+		}
+
+		valueFields := 1
+		if header.field == "pattern" {
+			valueFields = 0
+		}
+
+		for h := uint(0); h < dims[2]; h++ {
+			fields, err := next()
+			if err != nil {
+				return nil, err
+			}
+
+			if len(fields) != 2+valueFields {
+				return nil, ErrMatrixMarket(line, fmt.Sprintf("expected %d fields but got %d", 2+valueFields, len(fields)))
+			}
+
+			position, err := parseMTXUints(fields[:2], 2)
+			if err != nil {
+				return nil, ErrMatrixMarket(line, err.Error())
+			}
+
+			i, j := position[0], position[1]
+			if i == 0 || j == 0 || i > rows || j > columns {
+				return nil, ErrMatrixMarket(line, fmt.Sprintf("position %d %d is outside %dx%d", i, j, rows, columns))
+			}
+
+			v, err := parse(fields[2:])
+			if err != nil {
+				return nil, err
+			}
+
+			builder.Add(i-1, j-1, v)
+
+			if i != j {
+				switch header.symmetry {
+				case "symmetric":
+					builder.Add(j-1, i-1, v)
+				case "skew-symmetric":
+					builder.Add(j-1, i-1, -v)
+				}
+			}
+		}
+
+		return builder.Matrix()
+	}
+
+	if header.field == "pattern" {
+		return nil, ErrMatrixMarket(1, "the array format cannot have a pattern field")
+	}
+
+	store, err := NewDenseStore[T](rows, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	// Array values are listed in column major order, only the lower triangle for symmetric matrixes
+	for j := uint(0); j < columns; j++ {
+		start := uint(0)
+		switch header.symmetry {
+		case "symmetric":
+			start = j
+		case "skew-symmetric":
+			start = j + 1
+		}
+
+		for i := start; i < rows; i++ {
+			fields, err := next()
+			if err != nil {
+				return nil, err
+			}
+
+			if len(fields) != 1 {
+				return nil, ErrMatrixMarket(line, fmt.Sprintf("expected 1 field but got %d", len(fields)))
+			}
+
+			v, err := parse(fields)
+			if err != nil {
+				return nil, err
+			}
+
+			store.Write(i, j, v)
+
+			if i != j {
+				switch header.symmetry {
+				case "symmetric":
+					store.Write(j, i, v)
+				case "skew-symmetric":
+					store.Write(j, i, -v)
+				}
+			}
+		}
+	}
+
+	return NewMatrix[T](store)
+}
+
+/*
+Write the matrix in Matrix Market format with general symmetry. Matrixes where fewer
+than half of the elements are non zero are written in coordinate format, others in
+array format.
+*/
+func (m *Matrix[T]) WriteMatrixMarket(w io.Writer) error {
+	field := "integer"
+	if kind, _ := elementKind[T](); kind == 'f' {
+		field = "real"
+	}
+
+	var nonZeros uint
+	if s, ok := sparseReader(m); ok {
+		nonZeros = s.NonZeros()
+	} else {
+		for i := uint(0); i < m.rows; i++ {
+			for j := uint(0); j < m.columns; j++ {
+				if m.reader.Read(i, j) != 0 {
+					nonZeros++
+				}
+			}
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+
+	if nonZeros*2 < m.Size() {
+		fmt.Fprintf(bw, "%%%%MatrixMarket matrix coordinate %s general\n", field)
+		fmt.Fprintf(bw, "%d %d %d\n", m.rows, m.columns, nonZeros)
+
+		for i := uint(0); i < m.rows; i++ {
+			forEachNonZeroInRow(m, i, func(j uint, v T) {
+				fmt.Fprintf(bw, "%d %d %s\n", i+1, j+1, formatElement(v))
+			})
+		}
+
+		return bw.Flush()
+	}
+
+	fmt.Fprintf(bw, "%%%%MatrixMarket matrix array %s general\n", field)
+	fmt.Fprintf(bw, "%d %d\n", m.rows, m.columns)
+
+	for j := uint(0); j < m.columns; j++ {
+		for i := uint(0); i < m.rows; i++ {
+			fmt.Fprintf(bw, "%s\n", formatElement(m.reader.Read(i, j)))
+		}
+	}
+
+	return bw.Flush()
+}
+
+// Parse and validate the %%MatrixMarket banner line
+func parseMTXHeader(text string) (mtxHeader, error) {
+	fields := strings.Fields(strings.ToLower(text))
+
+	if len(fields) != 5 || fields[0] != "%%matrixmarket" {
+		return mtxHeader{}, ErrMatrixMarket(1, "expected %%MatrixMarket matrix <format> <field> <symmetry>")
+	}
+
+	if fields[1] != "matrix" {
+		return mtxHeader{}, ErrMatrixMarket(1, fmt.Sprintf("unsupported object %s", fields[1]))
+	}
+
+	header := mtxHeader{format: fields[2], field: fields[3], symmetry: fields[4]}
+
+	if header.format != "coordinate" && header.format != "array" {
+		return mtxHeader{}, ErrMatrixMarket(1, fmt.Sprintf("unsupported format %s", header.format))
+	}
+
+	switch header.field {
+	case "real", "double", "integer", "pattern":
+	default:
+		return mtxHeader{}, ErrMatrixMarket(1, fmt.Sprintf("unsupported field %s", header.field))
+	}
+
+	switch header.symmetry {
+	case "general", "symmetric", "skew-symmetric":
+	default:
+		return mtxHeader{}, ErrMatrixMarket(1, fmt.Sprintf("unsupported symmetry %s", header.symmetry))
+	}
+
+	return header, nil
+}
+
+func parseMTXUints(fields []string, count int) ([]uint, error) {
+	if len(fields) != count {
+		return nil, fmt.Errorf("expected %d fields but got %d", count, len(fields))
+	}
+
+	values := make([]uint, count)
+	for h, f := range fields {
+		v, err := strconv.ParseUint(f, 10, 0)
+		if err != nil {
+			return nil, fmt.Errorf("invalid integer %q", f)
+		}
+		values[h] = uint(v)
+	}
+
+	return values, nil
+}
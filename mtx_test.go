@@ -0,0 +1,221 @@
+package matrix
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestReadMatrixMarket(t *testing.T) {
+	t.Run("it reads a general coordinate matrix into a sparse store", func(t *testing.T) {
+		input := `%%MatrixMarket matrix coordinate real general
+% a comment
+3 4 3
+1 1 1.5
+2 4 -2
+3 2 3e1
+`
+		got, err := ReadMatrixMarket[float64](strings.NewReader(input))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := NewMatrixFromSlice([][]float64{
+			{1.5, 0, 0, 0},
+			{0, 0, 0, -2},
+			{0, 30, 0, 0},
+		})
+		matrixesAreEquivalent(t, got, want)
+
+		if !isSparse(got) {
+			t.Error("expected a sparse store")
+		}
+	})
+
+	t.Run("it expands a symmetric pattern matrix", func(t *testing.T) {
+		input := `%%MatrixMarket matrix coordinate pattern symmetric
+3 3 3
+1 1
+3 1
+3 2
+`
+		got, err := ReadMatrixMarket[uint8](strings.NewReader(input))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := NewMatrixFromSlice([][]uint8{
+			{1, 0, 1},
+			{0, 0, 1},
+			{1, 1, 0},
+		})
+		matrixesAreEquivalent(t, got, want)
+	})
+
+	t.Run("it expands a skew-symmetric coordinate matrix", func(t *testing.T) {
+		input := `%%MatrixMarket matrix coordinate integer skew-symmetric
+2 2 1
+2 1 5
+`
+		got, err := ReadMatrixMarket[int](strings.NewReader(input))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := NewMatrixFromSlice([][]int{
+			{0, -5},
+			{5, 0},
+		})
+		matrixesAreEquivalent(t, got, want)
+	})
+
+	t.Run("it reads a general array matrix in column major order", func(t *testing.T) {
+		input := `%%MatrixMarket matrix array integer general
+2 3
+1
+4
+2
+5
+3
+6
+`
+		got, err := ReadMatrixMarket[int16](strings.NewReader(input))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := NewMatrixFromSlice([][]int16{
+			{1, 2, 3},
+			{4, 5, 6},
+		})
+		matrixesAreEquivalent(t, got, want)
+	})
+
+	t.Run("it reads the lower triangle of a symmetric array matrix", func(t *testing.T) {
+		input := `%%MatrixMarket matrix array real symmetric
+2 2
+1
+2
+3
+`
+		got, err := ReadMatrixMarket[float32](strings.NewReader(input))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := NewMatrixFromSlice([][]float32{
+			{1, 2},
+			{2, 3},
+		})
+		matrixesAreEquivalent(t, got, want)
+	})
+
+	t.Run("it reads a skew-symmetric array matrix", func(t *testing.T) {
+		input := `%%MatrixMarket matrix array real skew-symmetric
+3 3
+1
+2
+3
+`
+		got, err := ReadMatrixMarket[float64](strings.NewReader(input))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := NewMatrixFromSlice([][]float64{
+			{0, -1, -2},
+			{1, 0, -3},
+			{2, 3, 0},
+		})
+		matrixesAreEquivalent(t, got, want)
+	})
+
+	cases := []struct {
+		name  string
+		input string
+	}{
+		{name: "missing banner", input: "3 3 0\n"},
+		{name: "complex field", input: "%%MatrixMarket matrix coordinate complex general\n1 1 0\n"},
+		{name: "hermitian symmetry", input: "%%MatrixMarket matrix coordinate real hermitian\n1 1 0\n"},
+		{name: "position out of range", input: "%%MatrixMarket matrix coordinate integer general\n2 2 1\n3 1 1\n"},
+		{name: "zero based position", input: "%%MatrixMarket matrix coordinate integer general\n2 2 1\n0 1 1\n"},
+		{name: "missing entries", input: "%%MatrixMarket matrix coordinate integer general\n2 2 2\n1 1 1\n"},
+		{name: "invalid value", input: "%%MatrixMarket matrix coordinate integer general\n2 2 1\n1 1 x\n"},
+		{name: "extra array fields", input: "%%MatrixMarket matrix array integer general\n1 2\n1 2\n3\n"},
+		{name: "non square symmetric", input: "%%MatrixMarket matrix array integer symmetric\n2 3\n"},
+		{name: "skew-symmetric unsigned", input: "%%MatrixMarket matrix coordinate integer skew-symmetric\n2 2 0\n"},
+	}
+
+	for _, test := range cases {
+		t.Run("it returns an error for "+test.name, func(t *testing.T) {
+			_, err := ReadMatrixMarket[uint](strings.NewReader(test.input))
+			if err == nil {
+				t.Error("expected error but got none")
+			}
+		})
+	}
+}
+
+func TestWriteMatrixMarket(t *testing.T) {
+	t.Run("it writes a mostly zero matrix in coordinate format", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice([][]int{
+			{0, 0, 3},
+			{0, 0, 0},
+			{-1, 0, 0},
+		})
+
+		var buf bytes.Buffer
+		err := m.WriteMatrixMarket(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := `%%MatrixMarket matrix coordinate integer general
+3 3 2
+1 3 3
+3 1 -1
+`
+		if buf.String() != want {
+			t.Errorf("expected %q, got %q", want, buf.String())
+		}
+	})
+
+	t.Run("it writes a dense matrix in array format", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice([][]float64{
+			{1.5, 2},
+			{0, 4},
+		})
+
+		var buf bytes.Buffer
+		m.WriteMatrixMarket(&buf)
+
+		want := `%%MatrixMarket matrix array real general
+2 2
+1.5
+0
+2
+4
+`
+		if buf.String() != want {
+			t.Errorf("expected %q, got %q", want, buf.String())
+		}
+	})
+
+	t.Run("it round trips through ReadMatrixMarket", func(t *testing.T) {
+		for _, data := range [][][]int32{
+			{{0, 0, 0}, {0, 7, 0}},
+			{{1, 2, 3}, {4, 0, 6}},
+		} {
+			m, _ := NewMatrixFromSlice(data)
+
+			var buf bytes.Buffer
+			m.WriteMatrixMarket(&buf)
+
+			got, err := ReadMatrixMarket[int32](&buf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			matrixesAreEquivalent(t, got, m)
+		}
+	})
+}
@@ -0,0 +1,147 @@
+package matrix
+
+import (
+	"runtime"
+	"sync"
+)
+
+/*
+Tuning for dense matrix multiplication. Workers is the number of goroutines that output
+row blocks are split across and BlockSize is the tile edge used to keep the working set
+in cache. Zero values fall back to the defaults.
+*/
+type MultiplyOptions struct {
+	Workers   int
+	BlockSize uint
+}
+
+const defaultBlockSize uint = 64
+
+// Use one worker per available CPU and 64 element tiles
+func DefaultMultiplyOptions() MultiplyOptions {
+	return MultiplyOptions{
+		Workers:   runtime.GOMAXPROCS(0),
+		BlockSize: defaultBlockSize,
+	}
+}
+
+/*
+Multiply two matrixes with the given tuning options. Multiply uses the default options.
+*/
+func (m Matrix[T]) MultiplyWithOptions(a *Matrix[T], opts MultiplyOptions) (*Matrix[T], error) {
+	if m.columns != a.rows {
+		return nil, ErrMultiplicationColumnRowMismatch
+	}
+
+	if isSparse(&m) || isSparse(a) {
+		return sparseMultiply(&m, a)
+	}
+
+	return denseMultiply(&m, a, opts)
+}
+
+/*
+Multiply using a tiled kernel over contiguous slices. Operands that are not already
+dense are packed into a dense store first, which is cheap next to the multiplication.
+The output rows are split into blocks that are handed to a pool of goroutines, each
+block is written by exactly one worker so no locking is needed on the result.
+*/
+func denseMultiply[T Element](m, a *Matrix[T], opts MultiplyOptions) (*Matrix[T], error) {
+	if opts.Workers < 1 {
+		opts.Workers = runtime.GOMAXPROCS(0)
+	}
+	if opts.BlockSize == 0 {
+		opts.BlockSize = defaultBlockSize
+	}
+
+	left := packDense(m)
+	right := packDense(a)
+
+	result, err := NewDenseStore[T](m.rows, a.columns)
+	if err != nil {
+		return nil, err
+	}
+
+	bs := opts.BlockSize
+	blocks := (m.rows + bs - 1) / bs
+
+	workers := uint(opts.Workers)
+	if workers > blocks {
+		workers = blocks
+	}
+
+	if workers == 1 {
+		multiplyRowBlock(left, right, result, 0, m.rows, bs)
+		return NewMatrix[T](result)
+	}
+
+	jobs := make(chan uint)
+	var wg sync.WaitGroup
+
+	for w := uint(0); w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for r0 := range jobs {
+				multiplyRowBlock(left, right, result, r0, min(r0+bs, m.rows), bs)
+			}
+		}()
+	}
+
+	for r0 := uint(0); r0 < m.rows; r0 += bs {
+		jobs <- r0
+	}
+	close(jobs)
+	wg.Wait()
+
+	return NewMatrix[T](result)
+}
+
+/*
+Compute rows r0 up to r1 of the product c = a * b. The inner dimension and the output
+columns are walked in tiles. Each output element still accumulates its products in
+ascending k order, so results match the naive triple loop exactly.
+*/
+func multiplyRowBlock[T Element](a, b, c *DenseStore[T], r0, r1, bs uint) {
+	inner := a.columns
+	columns := b.columns
+
+	for kk := uint(0); kk < inner; kk += bs {
+		kEnd := min(kk+bs, inner)
+
+		for jj := uint(0); jj < columns; jj += bs {
+			jEnd := min(jj+bs, columns)
+
+			for i := r0; i < r1; i++ {
+				aRow := a.row(i)
+				cRow := c.row(i)[jj:jEnd]
+
+				for k := kk; k < kEnd; k++ {
+					aik := aRow[k]
+					bRow := b.row(k)[jj:jEnd]
+
+					for j := range cRow {
+						cRow[j] += aik * bRow[j]
+					}
+				}
+			}
+		}
+	}
+}
+
+// Return the dense store of a matrix, copying into a new one for any other store
+func packDense[T Element](m *Matrix[T]) *DenseStore[T] {
+	if d, ok := m.reader.(*DenseStore[T]); ok {
+		return d
+	}
+
+	d, _ := NewDenseStore[T](m.rows, m.columns)
+	for i := uint(0); i < m.rows; i++ {
+		row := d.row(i)
+		for j := range row {
+			row[j] = m.reader.Read(i, uint(j))
+		}
+	}
+
+	return d
+}
@@ -0,0 +1,186 @@
+package matrix
+
+import (
+	"math"
+	"math/rand"
+)
+
+// The kind of norm computed by Norm and Distance
+type NormKind int
+
+const (
+	// The square root of the sum of the squares of every element
+	FrobeniusNorm NormKind = iota
+
+	// The largest sum of absolute values in a column
+	OneNorm
+
+	// The largest sum of absolute values in a row
+	InfinityNorm
+
+	// The largest absolute value of any element
+	MaxAbsNorm
+
+	/*
+		The largest singular value, estimated by power iteration on A^T A. Cheaper than a
+		full SVD for large matrixes, see NewSVD for an exact value.
+	*/
+	SpectralNorm
+)
+
+// The most power iterations used to estimate the spectral norm
+const maxSpectralIterations = 1000
+
+/*
+Return the norm of the matrix as a float64 for every element type. Only non zero
+elements are visited, so sparse matrixes are cheap. Returns ErrUnknownNormKind for an
+unsupported kind.
+*/
+func (m Matrix[T]) Norm(kind NormKind) (float64, error) {
+	return norm(m.rows, m.columns, kind, func(fn func(i, j uint, v float64)) {
+		forEachNonZero(&m, func(i, j uint, v T) {
+			fn(i, j, float64(v))
+		})
+	})
+}
+
+/*
+Return the norm of the difference between two matrixes of the same dimensions. The
+difference is taken in float64, so unsigned matrixes do not wrap.
+*/
+func Distance[T Element](a, b *Matrix[T], kind NormKind) (float64, error) {
+	if !AreSameDimensions(a, b) {
+		return 0, ErrMustBeSameDimensions
+	}
+
+	return norm(a.rows, a.columns, kind, func(fn func(i, j uint, v float64)) {
+		for i := uint(0); i < a.rows; i++ {
+			for j := uint(0); j < a.columns; j++ {
+				fn(i, j, float64(a.reader.Read(i, j))-float64(b.reader.Read(i, j)))
+			}
+		}
+	})
+}
+
+/*
+Compute a norm of a rows by columns matrix from a function that visits its elements.
+Elements that are not visited are zero.
+*/
+func norm(rows, columns uint, kind NormKind, each func(fn func(i, j uint, v float64))) (float64, error) {
+	switch kind {
+	case FrobeniusNorm:
+		var n float64
+		each(func(i, j uint, v float64) {
+			n = math.Hypot(n, v)
+		})
+		return n, nil
+
+	case OneNorm, InfinityNorm:
+		size := columns
+		if kind == InfinityNorm {
+			size = rows
+		}
+
+		sums := make([]float64, size)
+		each(func(i, j uint, v float64) {
+			if kind == OneNorm {
+				sums[j] += math.Abs(v)
+			} else {
+				sums[i] += math.Abs(v)
+			}
+		})
+
+		var n float64
+		for _, s := range sums {
+			n = math.Max(n, s)
+		}
+		return n, nil
+
+	case MaxAbsNorm:
+		var n float64
+		each(func(i, j uint, v float64) {
+			n = math.Max(n, math.Abs(v))
+		})
+		return n, nil
+
+	case SpectralNorm:
+		return spectralNorm(rows, columns, each), nil
+	}
+
+	return 0, ErrUnknownNormKind
+}
+
+/*
+Estimate the largest singular value by power iteration, repeatedly applying A^T A to a
+unit vector. Each estimate ||Ax|| is a lower bound that converges at the rate of
+(s2 / s1)^2 where s1 and s2 are the two largest singular values.
+*/
+func spectralNorm(rows, columns uint, each func(fn func(i, j uint, v float64))) float64 {
+	var nonZero bool
+	each(func(i, j uint, v float64) {
+		nonZero = nonZero || v != 0
+	})
+
+	if !nonZero {
+		return 0
+	}
+
+	// Start from a fixed pseudo random unit vector, which has a component along every right
+	// singular vector so the iteration cannot settle on a smaller singular value the way a
+	// coordinate vector or all ones can
+	rng := rand.New(rand.NewSource(1))
+	x := make([]float64, columns)
+	for j := range x {
+		x[j] = 1 + rng.Float64()
+		if rng.Intn(2) == 0 {
+			x[j] = -x[j]
+		}
+	}
+
+	var xNorm float64
+	for _, v := range x {
+		xNorm = math.Hypot(xNorm, v)
+	}
+	for j := range x {
+		x[j] /= xNorm
+	}
+
+	y := make([]float64, rows)
+	var estimate float64
+
+	for iter := 0; iter < maxSpectralIterations; iter++ {
+		// y = Ax
+		clear(y)
+		each(func(i, j uint, v float64) {
+			y[i] += v * x[j]
+		})
+
+		var yNorm float64
+		for _, v := range y {
+			yNorm = math.Hypot(yNorm, v)
+		}
+
+		previous := estimate
+		estimate = yNorm
+
+		if math.Abs(estimate-previous) <= 1e-14*estimate {
+			break
+		}
+
+		// x = A^T y normalized
+		clear(x)
+		each(func(i, j uint, v float64) {
+			x[j] += v * y[i]
+		})
+
+		xNorm = 0
+		for _, v := range x {
+			xNorm = math.Hypot(xNorm, v)
+		}
+		for j := range x {
+			x[j] /= xNorm
+		}
+	}
+
+	return estimate
+}
@@ -0,0 +1,140 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestNorm(t *testing.T) {
+	input := [][]int{
+		{1, -2, 3},
+		{-4, 5, -6},
+	}
+
+	cases := []struct {
+		name string
+		kind NormKind
+		want float64
+	}{
+		{name: "Frobenius", kind: FrobeniusNorm, want: math.Sqrt(91)},
+		{name: "one", kind: OneNorm, want: 9},
+		{name: "infinity", kind: InfinityNorm, want: 15},
+		{name: "max abs", kind: MaxAbsNorm, want: 6},
+		// The largest singular value, the square root of the largest eigenvalue of AA^T
+		{name: "spectral", kind: SpectralNorm, want: math.Sqrt((91 + math.Sqrt(91*91-4*(14*77-32*32))) / 2)},
+	}
+
+	for _, test := range cases {
+		t.Run("it returns the "+test.name+" norm", func(t *testing.T) {
+			m, _ := NewMatrixFromSlice(input)
+
+			got, err := m.Norm(test.kind)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if math.Abs(got-test.want) > 1e-10 {
+				t.Errorf("expected %v, got %v", test.want, got)
+			}
+
+			sparse := newSparseFromSlice(t, input)
+			got, _ = sparse.Norm(test.kind)
+			if math.Abs(got-test.want) > 1e-10 {
+				t.Errorf("expected %v for a sparse matrix, got %v", test.want, got)
+			}
+		})
+	}
+
+	t.Run("it matches the largest singular value", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice([][]float64{
+			{4, 0, 1, 2},
+			{2, -3, 2, 0},
+			{1, 1, 5, -1},
+			{0, 2, -1, 3},
+		})
+
+		got, _ := m.Norm(SpectralNorm)
+		f, _ := NewSVD(m)
+		if want := f.Values()[0]; math.Abs(got-want) > 1e-10 {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("it does not settle on a smaller singular value", func(t *testing.T) {
+		// The largest column, then the all ones vector, is orthogonal to the top right singular vector
+		for _, data := range [][][]float64{
+			{{2.1, 0, 0}, {0, 1.5, 1.5}},
+			{{1, -1}, {1, -1}},
+		} {
+			m, _ := NewMatrixFromSlice(data)
+
+			got, _ := m.Norm(SpectralNorm)
+			f, _ := NewSVD(m)
+			if want := f.Values()[0]; math.Abs(got-want) > 1e-10 {
+				t.Errorf("expected %v, got %v", want, got)
+			}
+		}
+	})
+
+	t.Run("it returns zero for the zero matrix", func(t *testing.T) {
+		m, _ := NewEmptyMatrix[float32](3, 3)
+
+		for _, kind := range []NormKind{FrobeniusNorm, OneNorm, InfinityNorm, MaxAbsNorm, SpectralNorm} {
+			got, err := m.Norm(kind)
+			if err != nil || got != 0 {
+				t.Errorf("expected 0 for kind %d, got %v, %v", kind, got, err)
+			}
+		}
+	})
+
+	t.Run("it returns an error for an unknown kind", func(t *testing.T) {
+		m, _ := NewIdentityMatrix[float64](2)
+
+		_, err := m.Norm(NormKind(99))
+		if !errors.Is(err, ErrUnknownNormKind) {
+			t.Errorf("expected ErrUnknownNormKind, got %v", err)
+		}
+	})
+}
+
+func TestDistance(t *testing.T) {
+	t.Run("it returns the norm of the difference", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice([][]float64{{1, 2}, {3, 4}})
+		b, _ := NewMatrixFromSlice([][]float64{{1, 0}, {6, 4}})
+
+		got, err := Distance(a, b, FrobeniusNorm)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if math.Abs(got-math.Sqrt(13)) > 1e-12 {
+			t.Errorf("expected %v, got %v", math.Sqrt(13), got)
+		}
+	})
+
+	t.Run("it does not wrap for unsigned matrixes", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice([][]uint8{{1, 200}})
+		b, _ := NewMatrixFromSlice([][]uint8{{3, 10}})
+
+		got, _ := Distance(a, b, MaxAbsNorm)
+		if got != 190 {
+			t.Errorf("expected 190, got %v", got)
+		}
+
+		got, _ = Distance(b, a, OneNorm)
+		if got != 190 {
+			t.Errorf("expected 190, got %v", got)
+		}
+	})
+
+	t.Run("it returns an error if the dimensions differ", func(t *testing.T) {
+		a, _ := NewEmptyMatrix[float64](2, 2)
+		b, _ := NewEmptyMatrix[float64](2, 3)
+
+		_, err := Distance(a, b, FrobeniusNorm)
+		if !errors.Is(err, ErrMustBeSameDimensions) {
+			t.Errorf("expected ErrMustBeSameDimensions, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,241 @@
+package matrix
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var npyMagic = []byte("\x93NUMPY")
+
+/*
+Returned when the dtype of a .npy file does not match the element type requested from
+ReadNPY. Descr is the dtype in the file and Expected is the dtype T maps to.
+*/
+type DTypeMismatchError struct {
+	Descr    string
+	Expected string
+}
+
+func (e *DTypeMismatchError) Error() string {
+	return fmt.Sprintf("npy dtype %s does not match requested element type, expected %s", e.Descr, e.Expected)
+}
+
+var (
+	npyDescrPattern   = regexp.MustCompile(`'descr'\s*:\s*'([^']*)'`)
+	npyFortranPattern = regexp.MustCompile(`'fortran_order'\s*:\s*(True|False)`)
+	npyShapePattern   = regexp.MustCompile(`'shape'\s*:\s*\(([^)]*)\)`)
+)
+
+/*
+Read a matrix from a NumPy .npy stream. The file's dtype must match T exactly, for
+example <f8 for float64 or |u1 for uint8, otherwise a *DTypeMismatchError is returned.
+Both C and Fortran order are supported. A one dimensional array is read as a single
+row.
+*/
+func ReadNPY[T Element](r io.Reader) (*Matrix[T], error) {
+	br := bufio.NewReader(r)
+
+	header, err := readNPYHeader(br)
+	if err != nil {
+		return nil, err
+	}
+
+	match := npyDescrPattern.FindStringSubmatch(header)
+	if match == nil {
+		return nil, ErrNPYHeader("missing descr")
+	}
+	descr := match[1]
+
+	order, kind, size, err := parseNPYDescr(descr)
+	if err != nil {
+		return nil, err
+	}
+
+	expectedKind, expectedSize := elementKind[T]()
+	if kind != expectedKind || size != expectedSize {
+		return nil, &DTypeMismatchError{Descr: descr, Expected: npyDescr[T]()}
+	}
+
+	match = npyFortranPattern.FindStringSubmatch(header)
+	if match == nil {
+		return nil, ErrNPYHeader("missing fortran_order")
+	}
+	fortran := match[1] == "True"
+
+	rows, columns, err := parseNPYShape(header)
+	if err != nil {
+		return nil, err
+	}
+
+	store, err := NewDenseStore[T](rows, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, size)
+	total := rows * columns
+
+	for h := uint(0); h < total; h++ {
+		_, err := io.ReadFull(br, buf)
+		if err != nil {
+			return nil, err
+		}
+
+		v := decodeElement[T](buf, kind, order)
+
+		if fortran {
+			store.data[(h%rows)*columns+h/rows] = v
+		} else {
+			store.data[h] = v
+		}
+	}
+
+	return NewMatrix[T](store)
+}
+
+/*
+Write the matrix as a version 1.0 NumPy .npy stream in little endian C order. The
+dtype is derived from T, int and uint are written as 64 bit values.
+*/
+func (m *Matrix[T]) WriteNPY(w io.Writer) error {
+	header := fmt.Sprintf("{'descr': '%s', 'fortran_order': False, 'shape': (%d, %d), }",
+		npyDescr[T](), m.rows, m.columns)
+
+	// The magic, version and length prefix plus the header and a newline are padded to 64 bytes
+	prefix := len(npyMagic) + 4
+	padding := 64 - (prefix+len(header)+1)%64
+	if padding == 64 {
+		padding = 0
+	}
+	header += strings.Repeat(" ", padding) + "\n"
+
+	bw := bufio.NewWriter(w)
+	bw.Write(npyMagic)
+	bw.Write([]byte{1, 0})
+	binary.Write(bw, binary.LittleEndian, uint16(len(header)))
+	bw.WriteString(header)
+
+	kind, size := elementKind[T]()
+	buf := make([]byte, size)
+
+	for i := uint(0); i < m.rows; i++ {
+		for j := uint(0); j < m.columns; j++ {
+			encodeElement(buf, kind, m.reader.Read(i, j))
+			_, err := bw.Write(buf)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return bw.Flush()
+}
+
+// Read the magic string, version and header dictionary
+func readNPYHeader(r io.Reader) (string, error) {
+	prefix := make([]byte, len(npyMagic)+2)
+	_, err := io.ReadFull(r, prefix)
+	if err != nil {
+		return "", err
+	}
+
+	if string(prefix[:len(npyMagic)]) != string(npyMagic) {
+		return "", ErrNPYHeader("missing magic string")
+	}
+
+	var length uint32
+	switch major := prefix[len(npyMagic)]; major {
+	case 1:
+		var short uint16
+		err = binary.Read(r, binary.LittleEndian, &short)
+		length = uint32(short)
+	case 2, 3:
+		err = binary.Read(r, binary.LittleEndian, &length)
+	default:
+		return "", ErrNPYHeader(fmt.Sprintf("unsupported version %d", major))
+	}
+	if err != nil {
+		return "", err
+	}
+
+	header := make([]byte, length)
+	_, err = io.ReadFull(r, header)
+	if err != nil {
+		return "", err
+	}
+
+	return string(header), nil
+}
+
+/*
+Split a dtype description such as <f8 into its byte order, kind and item size. Native
+order is treated as little endian.
+*/
+func parseNPYDescr(descr string) (binary.ByteOrder, byte, int, error) {
+	if len(descr) < 3 {
+		return nil, 0, 0, ErrNPYHeader(fmt.Sprintf("unsupported dtype %s", descr))
+	}
+
+	var order binary.ByteOrder
+	switch descr[0] {
+	case '<', '|', '=':
+		order = binary.LittleEndian
+	case '>':
+		order = binary.BigEndian
+	default:
+		return nil, 0, 0, ErrNPYHeader(fmt.Sprintf("unsupported dtype %s", descr))
+	}
+
+	kind := descr[1]
+	size, err := strconv.Atoi(descr[2:])
+	if err != nil || (kind != 'i' && kind != 'u' && kind != 'f') {
+		return nil, 0, 0, ErrNPYHeader(fmt.Sprintf("unsupported dtype %s", descr))
+	}
+
+	return order, kind, size, nil
+}
+
+// Parse a one or two dimensional shape tuple
+func parseNPYShape(header string) (uint, uint, error) {
+	match := npyShapePattern.FindStringSubmatch(header)
+	if match == nil {
+		return 0, 0, ErrNPYHeader("missing shape")
+	}
+
+	var dims []uint
+	for _, part := range strings.Split(match[1], ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		d, err := strconv.ParseUint(part, 10, 0)
+		if err != nil {
+			return 0, 0, ErrNPYHeader(fmt.Sprintf("invalid shape (%s)", match[1]))
+		}
+		dims = append(dims, uint(d))
+	}
+
+	switch len(dims) {
+	case 1:
+		return 1, dims[0], nil
+	case 2:
+		return dims[0], dims[1], nil
+	default:
+		return 0, 0, ErrNPYHeader(fmt.Sprintf("shape (%s) must have one or two dimensions", match[1]))
+	}
+}
+
+// The little endian dtype description for T, single byte types have no byte order
+func npyDescr[T Element]() string {
+	kind, size := elementKind[T]()
+	if size == 1 {
+		return fmt.Sprintf("|%c%d", kind, size)
+	}
+	return fmt.Sprintf("<%c%d", kind, size)
+}
@@ -0,0 +1,187 @@
+package matrix
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// Build a version 1.0 npy stream the way NumPy lays it out
+func npyBytes(t *testing.T, descr string, fortran bool, shape string, data any, order binary.ByteOrder) []byte {
+	t.Helper()
+
+	fortranOrder := "False"
+	if fortran {
+		fortranOrder = "True"
+	}
+	header := fmt.Sprintf("{'descr': '%s', 'fortran_order': %s, 'shape': %s, }", descr, fortranOrder, shape)
+	for (10+len(header)+1)%64 != 0 {
+		header += " "
+	}
+	header += "\n"
+
+	var buf bytes.Buffer
+	buf.WriteString("\x93NUMPY\x01\x00")
+	binary.Write(&buf, binary.LittleEndian, uint16(len(header)))
+	buf.WriteString(header)
+	if err := binary.Write(&buf, order, data); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+func TestReadNPY(t *testing.T) {
+	t.Run("it reads C order data", func(t *testing.T) {
+		input := npyBytes(t, "<i4", false, "(2, 3)", []int32{1, 2, 3, 4, 5, -6}, binary.LittleEndian)
+
+		got, err := ReadNPY[int32](bytes.NewReader(input))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := NewMatrixFromSlice([][]int32{
+			{1, 2, 3},
+			{4, 5, -6},
+		})
+		matrixesAreEquivalent(t, got, want)
+	})
+
+	t.Run("it reads Fortran order data", func(t *testing.T) {
+		input := npyBytes(t, "<f8", true, "(2, 3)", []float64{1, 4, 2, 5, 3, 6}, binary.LittleEndian)
+
+		got, err := ReadNPY[float64](bytes.NewReader(input))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := NewMatrixFromSlice([][]float64{
+			{1, 2, 3},
+			{4, 5, 6},
+		})
+		matrixesAreEquivalent(t, got, want)
+	})
+
+	t.Run("it reads big endian data", func(t *testing.T) {
+		input := npyBytes(t, ">i2", false, "(1, 2)", []int16{-300, 300}, binary.BigEndian)
+
+		got, err := ReadNPY[int16](bytes.NewReader(input))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := NewMatrixFromSlice([][]int16{{-300, 300}})
+		matrixesAreEquivalent(t, got, want)
+	})
+
+	t.Run("it reads a one dimensional array as a row", func(t *testing.T) {
+		input := npyBytes(t, "|u1", false, "(3,)", []uint8{7, 8, 255}, binary.LittleEndian)
+
+		got, err := ReadNPY[uint8](bytes.NewReader(input))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := NewMatrixFromSlice([][]uint8{{7, 8, 255}})
+		matrixesAreEquivalent(t, got, want)
+	})
+
+	t.Run("it returns a typed error for a dtype mismatch", func(t *testing.T) {
+		input := npyBytes(t, "<f4", false, "(1, 1)", []float32{1}, binary.LittleEndian)
+
+		_, err := ReadNPY[float64](bytes.NewReader(input))
+
+		var mismatch *DTypeMismatchError
+		if !errors.As(err, &mismatch) {
+			t.Fatalf("expected DTypeMismatchError, got %v", err)
+		}
+
+		if mismatch.Descr != "<f4" || mismatch.Expected != "<f8" {
+			t.Errorf("got %+v", mismatch)
+		}
+	})
+
+	t.Run("it returns an error for more than two dimensions", func(t *testing.T) {
+		input := npyBytes(t, "<i8", false, "(1, 1, 1)", []int64{1}, binary.LittleEndian)
+
+		_, err := ReadNPY[int64](bytes.NewReader(input))
+		if err == nil {
+			t.Error("expected error but got none")
+		}
+	})
+
+	t.Run("it returns an error for a missing magic string", func(t *testing.T) {
+		_, err := ReadNPY[int64](bytes.NewReader([]byte("PK\x03\x04 not npy data")))
+		if err == nil {
+			t.Error("expected error but got none")
+		}
+	})
+
+	t.Run("it returns an error for truncated data", func(t *testing.T) {
+		input := npyBytes(t, "<i4", false, "(2, 2)", []int32{1, 2, 3}, binary.LittleEndian)
+
+		_, err := ReadNPY[int32](bytes.NewReader(input))
+		if err == nil {
+			t.Error("expected error but got none")
+		}
+	})
+}
+
+func TestWriteNPY(t *testing.T) {
+	t.Run("it writes the layout NumPy produces", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice([][]int32{
+			{1, 2, 3},
+			{4, 5, -6},
+		})
+
+		var buf bytes.Buffer
+		err := m.WriteNPY(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := npyBytes(t, "<i4", false, "(2, 3)", []int32{1, 2, 3, 4, 5, -6}, binary.LittleEndian)
+		if !bytes.Equal(buf.Bytes(), want) {
+			t.Errorf("expected %q, got %q", want, buf.Bytes())
+		}
+
+		if (bytes.IndexByte(buf.Bytes(), '\n')+1)%64 != 0 {
+			t.Error("expected the header to be padded to 64 bytes")
+		}
+	})
+
+	t.Run("it round trips every element type", func(t *testing.T) {
+		assertNPYRoundTrip(t, [][]int{{-1, 1 << 40}})
+		assertNPYRoundTrip(t, [][]int8{{-128, 127}})
+		assertNPYRoundTrip(t, [][]int16{{-32768, 32767}})
+		assertNPYRoundTrip(t, [][]int32{{-1 << 31, 1<<31 - 1}})
+		assertNPYRoundTrip(t, [][]int64{{-1 << 63, 1<<63 - 1}})
+		assertNPYRoundTrip(t, [][]uint{{0, 1 << 63}})
+		assertNPYRoundTrip(t, [][]uint8{{0, 255}})
+		assertNPYRoundTrip(t, [][]uint16{{0, 65535}})
+		assertNPYRoundTrip(t, [][]uint32{{0, 1<<32 - 1}})
+		assertNPYRoundTrip(t, [][]uint64{{0, 1<<64 - 1}})
+		assertNPYRoundTrip(t, [][]float32{{-1.5, 3.25e10}})
+		assertNPYRoundTrip(t, [][]float64{{-1.5, 3.25e300}})
+	})
+}
+
+func assertNPYRoundTrip[T Element](t *testing.T, data [][]T) {
+	t.Helper()
+
+	m, _ := NewMatrixFromSlice(data)
+
+	var buf bytes.Buffer
+	if err := m.WriteNPY(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := ReadNPY[T](&buf)
+	if err != nil {
+		t.Fatalf("%T: %v", data, err)
+	}
+
+	matrixesAreEquivalent(t, got, m)
+}
@@ -8,63 +8,37 @@ func (m *Matrix[T]) Set(i, j uint, value T) (*Matrix[T], error) {
 		return nil, ErrMatrixOutOfBounds
 	}
 
-	m.writer.Write(i, j, value)
+	if err := m.writable(); err != nil {
+		return nil, err
+	}
+
+	m.write(i, j, value)
 	return m, nil
 }
 
 func (m *Matrix[T]) AddInPlace(a *Matrix[T]) (*Matrix[T], error) {
-	if !AreSameDimensions(m, a) {
-		return nil, ErrMustBeSameDimensions
-	}
-
-	for i := uint(0); i < m.rows; i++ {
-		for j := uint(0); j < m.columns; j++ {
-			newVal := m.reader.Read(i, j) + a.reader.Read(i, j)
-			m.writer.Write(i, j, newVal)
-		}
-	}
-	return m, nil
+	return m.zipWithInPlace(a, func(x, y T) T {
+		return x + y
+	})
 }
 
 func (m *Matrix[T]) SubtractInPlace(a *Matrix[T]) (*Matrix[T], error) {
-	if !AreSameDimensions(m, a) {
-		return nil, ErrMustBeSameDimensions
-	}
-
-	for i := uint(0); i < m.rows; i++ {
-		for j := uint(0); j < m.columns; j++ {
-			newVal := m.reader.Read(i, j) - a.reader.Read(i, j)
-			m.writer.Write(i, j, newVal)
-		}
-	}
-
-	return m, nil
+	return m.zipWithInPlace(a, func(x, y T) T {
+		return x - y
+	})
 }
 
 /*
 Performs the scalar multiplication operation but on the original matrix
 */
-func (m *Matrix[T]) ScalarMultiplyInPlace(c T) *Matrix[T] {
-	for i := uint(0); i < m.rows; i++ {
-		for j := uint(0); j < m.columns; j++ {
-			newVal := m.reader.Read(i, j) * c
-			m.writer.Write(i, j, newVal)
-		}
-	}
-	return m
+func (m *Matrix[T]) ScalarMultiplyInPlace(c T) (*Matrix[T], error) {
+	return m.ApplyInPlace(func(v T) T {
+		return v * c
+	})
 }
 
 func (m *Matrix[T]) HadamardProductInPlace(a *Matrix[T]) (*Matrix[T], error) {
-	if !AreSameDimensions(m, a) {
-		return nil, ErrMustBeSameDimensions
-	}
-
-	for i := uint(0); i < m.rows; i++ {
-		for j := uint(0); j < m.columns; j++ {
-			product := m.reader.Read(i, j) * a.reader.Read(i, j)
-			m.writer.Write(i, j, product)
-		}
-	}
-
-	return m, nil
+	return m.zipWithInPlace(a, func(x, y T) T {
+		return x * y
+	})
 }
@@ -1,6 +1,7 @@
 package matrix
 
 import (
+	"errors"
 	"testing"
 )
 
@@ -87,7 +88,7 @@ func TestScalarMultiplyInPlace(t *testing.T) {
 		}
 		want, _ := NewMatrixFromSlice(input)
 
-		result := matrix.ScalarMultiplyInPlace(3)
+		result, _ := matrix.ScalarMultiplyInPlace(3)
 
 		if !pointersAreSame(result, matrix) {
 			t.Error("Expected receiver and return value to be pointed to the same matrix.")
@@ -160,3 +161,43 @@ func TestSet(t *testing.T) {
 		}
 	})
 }
+
+func TestReadOnlyInPlace(t *testing.T) {
+	input := [][]int{
+		{1, 2},
+		{3, 4},
+	}
+	other, _ := NewMatrixFromSlice(input)
+
+	cases := []struct {
+		name string
+		fn   func(m *Matrix[int]) (*Matrix[int], error)
+	}{
+		{name: "Set", fn: func(m *Matrix[int]) (*Matrix[int], error) { return m.Set(0, 0, 1) }},
+		{name: "Fill", fn: func(m *Matrix[int]) (*Matrix[int], error) { return m.Fill(1) }},
+		{name: "Zero", fn: func(m *Matrix[int]) (*Matrix[int], error) { return m.Zero() }},
+		{name: "AddInPlace", fn: func(m *Matrix[int]) (*Matrix[int], error) { return m.AddInPlace(other) }},
+		{name: "SubtractInPlace", fn: func(m *Matrix[int]) (*Matrix[int], error) { return m.SubtractInPlace(other) }},
+		{name: "ScalarMultiplyInPlace", fn: func(m *Matrix[int]) (*Matrix[int], error) { return m.ScalarMultiplyInPlace(2) }},
+		{name: "HadamardProductInPlace", fn: func(m *Matrix[int]) (*Matrix[int], error) { return m.HadamardProductInPlace(other) }},
+		{name: "ApplyInPlace", fn: func(m *Matrix[int]) (*Matrix[int], error) { return m.ApplyInPlace(func(v int) int { return v }) }},
+		{name: "SwapRows", fn: func(m *Matrix[int]) (*Matrix[int], error) { return m.SwapRows(0, 1) }},
+		{name: "SwapColumns", fn: func(m *Matrix[int]) (*Matrix[int], error) { return m.SwapColumns(0, 1) }},
+		{name: "Permute", fn: func(m *Matrix[int]) (*Matrix[int], error) { return m.Permute([]uint{1, 0}, nil) }},
+		{name: "InsertRow", fn: func(m *Matrix[int]) (*Matrix[int], error) { return m.InsertRow(0, []int{1, 2}) }},
+		{name: "DeleteRow", fn: func(m *Matrix[int]) (*Matrix[int], error) { return m.DeleteRow(0) }},
+		{name: "InsertColumn", fn: func(m *Matrix[int]) (*Matrix[int], error) { return m.InsertColumn(0, []int{1, 2}) }},
+		{name: "DeleteColumn", fn: func(m *Matrix[int]) (*Matrix[int], error) { return m.DeleteColumn(0) }},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name+" returns ErrReadOnly", func(t *testing.T) {
+			m, _ := NewMatrix[int](newReadOnlyStore(input))
+
+			_, err := test.fn(m)
+			if !errors.Is(err, ErrReadOnly) {
+				t.Errorf("expected ErrReadOnly, got %v", err)
+			}
+		})
+	}
+}
@@ -8,100 +8,42 @@ func (m Matrix[T]) Add(a *Matrix[T]) (*Matrix[T], error) {
 		return nil, ErrMustBeSameDimensions
 	}
 
-	result, err := NewEmptyMatrix[T](m.rows, m.columns)
-	if err != nil {
-		return nil, err
+	if isSparse(&m) || isSparse(a) {
+		return sparseAdd(&m, a)
 	}
 
-	for i := uint(0); i < m.rows; i++ {
-		for j := uint(0); j < m.columns; j++ {
-			newVal := m.reader.Read(i, j) + a.reader.Read(i, j)
-			result.writer.Write(i, j, newVal)
-		}
-	}
-
-	return result, nil
+	return m.ZipWith(a, func(x, y T) T {
+		return x + y
+	})
 }
 
 func (m Matrix[T]) Subtract(a *Matrix[T]) (*Matrix[T], error) {
-	if !AreSameDimensions(&m, a) {
-		return nil, ErrMustBeSameDimensions
-	}
-
-	result, err := NewEmptyMatrix[T](m.rows, m.columns)
-	if err != nil {
-		return nil, err
-	}
-
-	for i := uint(0); i < m.rows; i++ {
-		for j := uint(0); j < m.columns; j++ {
-			newVal := m.reader.Read(i, j) - a.reader.Read(i, j)
-			result.writer.Write(i, j, newVal)
-		}
-	}
-
-	return result, nil
+	return m.ZipWith(a, func(x, y T) T {
+		return x - y
+	})
 }
 
 /*
 Performs scalar multiplication on a matrix returning a new result matrix
 */
 func (m Matrix[T]) ScalarMultiply(c T) (*Matrix[T], error) {
-	result, err := NewEmptyMatrix[T](m.rows, m.columns)
-	if err != nil {
-		return nil, err
-	}
-
-	for i := uint(0); i < m.rows; i++ {
-		for j := uint(0); j < m.columns; j++ {
-			newVal := c * m.reader.Read(i, j)
-			result.writer.Write(i, j, newVal)
-		}
-	}
-
-	return result, nil
+	return m.Map(func(v T) T {
+		return c * v
+	})
 }
 
+/*
+Multiply two matrixes using a cache blocked kernel split across one goroutine per CPU.
+Use MultiplyWithOptions to tune the worker count and tile size.
+*/
 func (m Matrix[T]) Multiply(a *Matrix[T]) (*Matrix[T], error) {
-	// The number of columns in the first matrix must be equal to the number of rows in the second
-	if m.columns != a.rows {
-		return nil, ErrMultiplicationColumnRowMismatch
-	}
-
-	// The new matrix has the number of rows of the first and the number of columns of the second
-	new, _ := NewEmptyMatrix[T](m.rows, a.columns)
-
-	for i := uint(0); i < m.rows; i++ {
-		for j := uint(0); j < a.columns; j++ {
-			var sum T
-			for k := uint(0); k < m.columns; k++ {
-				sum += m.reader.Read(i, k) * a.reader.Read(k, j)
-			}
-			new.writer.Write(i, j, sum)
-		}
-	}
-
-	return new, nil
+	return m.MultiplyWithOptions(a, DefaultMultiplyOptions())
 }
 
 func (m Matrix[T]) HadamardProduct(a *Matrix[T]) (*Matrix[T], error) {
-	if !AreSameDimensions(&m, a) {
-		return nil, ErrMustBeSameDimensions
-	}
-
-	new, err := NewEmptyMatrix[T](m.rows, m.columns)
-	if err != nil {
-		return nil, err
-	}
-
-	for i := uint(0); i < m.rows; i++ {
-		for j := uint(0); j < m.columns; j++ {
-			product := m.reader.Read(i, j) * a.reader.Read(i, j)
-			new.writer.Write(i, j, product)
-		}
-	}
-
-	return new, nil
+	return m.ZipWith(a, func(x, y T) T {
+		return x * y
+	})
 }
 
 /*
@@ -146,6 +88,11 @@ position and values and boolean noting if it was found or not.
 func (m Matrix[T]) Search(element T) ([]Location[T], bool) {
 	var found []Location[T]
 
+	if m.ordered != nil {
+		found = m.orderedRange(element, element)
+		return found, len(found) > 0
+	}
+
 	if m.HasIndex() {
 		indexResult, ok := m.index[element]
 
@@ -162,6 +109,11 @@ func (m Matrix[T]) Search(element T) ([]Location[T], bool) {
 		}
 	}
 
+	if s, ok := sparseReader(&m); ok {
+		found = sparseSearch(s, m.rows, m.columns, element)
+		return found, len(found) > 0
+	}
+
 	for i := uint(0); i < m.rows; i++ {
 		for j := uint(0); j < m.columns; j++ {
 			if m.reader.Read(i, j) == element {
@@ -182,6 +134,10 @@ func (m Matrix[T]) Search(element T) ([]Location[T], bool) {
 }
 
 func (m Matrix[T]) Transpose() (*Matrix[T], error) {
+	if s, ok := sparseReader(&m); ok {
+		return sparseTranspose(s, m.rows, m.columns)
+	}
+
 	new, err := NewEmptyMatrix[T](m.columns, m.rows)
 	if err != nil {
 		return nil, err
@@ -197,6 +153,22 @@ func (m Matrix[T]) Transpose() (*Matrix[T], error) {
 }
 
 func (m Matrix[T]) Flatten() []T {
+	if s, ok := sparseReader(&m); ok {
+		result := make([]T, m.rows*m.columns)
+		s.ForEachNonZero(func(i, j uint, v T) {
+			result[i*m.columns+j] = v
+		})
+		return result
+	}
+
+	if d, ok := m.reader.(*DenseStore[T]); ok {
+		result := make([]T, 0, m.rows*m.columns)
+		for i := uint(0); i < m.rows; i++ {
+			result = append(result, d.row(i)...)
+		}
+		return result
+	}
+
 	result := []T{}
 
 	for i := uint(0); i < m.rows; i++ {
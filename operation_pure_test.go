@@ -1,6 +1,8 @@
 package matrix
 
 import (
+	"fmt"
+	"math/rand"
 	"reflect"
 	"testing"
 )
@@ -48,7 +50,7 @@ func TestAdd(t *testing.T) {
 			t.Error(err)
 		}
 
-		matrixesAreEqual(t, want, got)
+		matrixesAreEquivalent(t, want, got)
 	})
 
 	t.Run("it errors if the matrixes have different dimensions", func(t *testing.T) {
@@ -87,7 +89,7 @@ func TestScalarMultiply(t *testing.T) {
 			t.Error(err)
 		}
 
-		matrixesAreEqual(t, result, want)
+		matrixesAreEquivalent(t, result, want)
 	})
 }
 
@@ -127,7 +129,7 @@ func TestMultiply(t *testing.T) {
 		}
 		want, _ := NewMatrixFromSlice(input)
 
-		matrixesAreEqual(t, r, want)
+		matrixesAreEquivalent(t, r, want)
 	})
 }
 
@@ -153,7 +155,7 @@ func TestHadamardProduct(t *testing.T) {
 
 		r, _ := a.HadamardProduct(b)
 
-		matrixesAreEqual(t, r, want)
+		matrixesAreEquivalent(t, r, want)
 	})
 
 	t.Run("it returns an error if the matrixes don't have the same dimensions", func(t *testing.T) {
@@ -183,7 +185,7 @@ func TestPower(t *testing.T) {
 
 		r, _ := a.Power(3)
 
-		matrixesAreEqual(t, r, want)
+		matrixesAreEquivalent(t, r, want)
 	})
 
 	t.Run("it returns an error if the matrix is not square", func(t *testing.T) {
@@ -320,7 +322,7 @@ func TestTranspose(t *testing.T) {
 			t.Error(err)
 		}
 
-		matrixesAreEqual(t, got, want)
+		matrixesAreEquivalent(t, got, want)
 	})
 }
 
@@ -384,7 +386,7 @@ func TestExpand(t *testing.T) {
 
 		got, _ := ExpandSliceToMatrix(s, 3, 3)
 
-		matrixesAreEqual(t, got, want)
+		matrixesAreEquivalent(t, got, want)
 	})
 
 	t.Run("it returns an error if the slice cannot fit in the matrix dimensions", func(t *testing.T) {
@@ -409,6 +411,118 @@ func TestExpand(t *testing.T) {
 
 		got, _ := ExpandSliceToMatrix(s, 3, 3)
 
-		matrixesAreEqual(t, got, want)
+		matrixesAreEquivalent(t, got, want)
 	})
 }
+
+func TestMultiplyWithOptions(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+
+	a := randomMatrix[int](r, 37, 53)
+	b := randomMatrix[int](r, 53, 29)
+	want := naiveMultiply(a, b)
+
+	cases := []struct {
+		name string
+		opts MultiplyOptions
+	}{
+		{name: "defaults", opts: DefaultMultiplyOptions()},
+		{name: "single worker", opts: MultiplyOptions{Workers: 1, BlockSize: 8}},
+		{name: "uneven blocks", opts: MultiplyOptions{Workers: 4, BlockSize: 7}},
+		{name: "more workers than blocks", opts: MultiplyOptions{Workers: 64, BlockSize: 16}},
+		{name: "zero values", opts: MultiplyOptions{}},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := a.MultiplyWithOptions(b, test.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			matrixesAreEqual(t, got, want)
+		})
+	}
+
+	t.Run("it matches the naive float results exactly", func(t *testing.T) {
+		x := randomMatrix[float64](r, 40, 40)
+		y := randomMatrix[float64](r, 40, 40)
+
+		got, _ := x.MultiplyWithOptions(y, MultiplyOptions{Workers: 3, BlockSize: 9})
+		matrixesAreEqual(t, got, naiveMultiply(x, y))
+	})
+
+	t.Run("it multiplies strided dense stores", func(t *testing.T) {
+		data := []int{
+			1, 2, 0,
+			3, 4, 0,
+		}
+		store, _ := NewDenseStoreFromSlice(data, 2, 2, 3)
+		x, _ := NewMatrix[int](store)
+
+		got, _ := x.MultiplyWithOptions(x, MultiplyOptions{Workers: 2, BlockSize: 1})
+		want, _ := NewMatrixFromSlice([][]int{{7, 10}, {15, 22}})
+		matrixesAreEquivalent(t, got, want)
+	})
+
+	t.Run("it returns an error if columns in receiver does not equal rows in param", func(t *testing.T) {
+		_, err := a.MultiplyWithOptions(a, DefaultMultiplyOptions())
+		if err == nil {
+			t.Error("expected error but got none")
+		}
+	})
+}
+
+func BenchmarkMultiply(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+
+	for _, size := range []uint{64, 256, 512} {
+		x := randomMatrix[float64](r, size, size)
+		y := randomMatrix[float64](r, size, size)
+
+		b.Run(fmt.Sprintf("naive/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				naiveMultiply(x, y)
+			}
+		})
+
+		b.Run(fmt.Sprintf("blocked/%d", size), func(b *testing.B) {
+			opts := MultiplyOptions{Workers: 1, BlockSize: defaultBlockSize}
+			for i := 0; i < b.N; i++ {
+				x.MultiplyWithOptions(y, opts)
+			}
+		})
+
+		b.Run(fmt.Sprintf("parallel/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				x.Multiply(y)
+			}
+		})
+	}
+}
+
+// The original triple loop multiplication reading every element through the DataReader
+func naiveMultiply[T Element](m, a *Matrix[T]) *Matrix[T] {
+	new, _ := NewEmptyMatrix[T](m.rows, a.columns)
+
+	for i := uint(0); i < m.rows; i++ {
+		for j := uint(0); j < a.columns; j++ {
+			var sum T
+			for k := uint(0); k < m.columns; k++ {
+				sum += m.reader.Read(i, k) * a.reader.Read(k, j)
+			}
+			new.writer.Write(i, j, sum)
+		}
+	}
+
+	return new
+}
+
+func randomMatrix[T Element](r *rand.Rand, rows, columns uint) *Matrix[T] {
+	m, _ := NewEmptyMatrix[T](rows, columns)
+	for i := uint(0); i < rows; i++ {
+		for j := uint(0); j < columns; j++ {
+			m.writer.Write(i, j, T(r.Intn(20))-T(r.Intn(10)))
+		}
+	}
+	return m
+}
@@ -0,0 +1,274 @@
+package matrix
+
+import "math"
+
+/*
+The QR decomposition of a matrix using Householder reflections, A = QR or AP = QR when
+columns are pivoted. The Householder vectors are packed below the diagonal of qr and R
+above it, with the diagonal of R held separately.
+*/
+type QR[T Float] struct {
+	rows    uint
+	columns uint
+	steps   uint
+	qr      [][]T
+	rdiag   []T
+	pivot   []uint
+	pivoted bool
+	rank    uint
+}
+
+/*
+Factor a matrix into an orthogonal matrix Q and an upper triangular matrix R. The matrix
+may have any shape, LeastSquares requires the columns to be linearly independent.
+*/
+func NewQR[T Float](m *Matrix[T]) (*QR[T], error) {
+	return newQR(m, false)
+}
+
+/*
+Factor a matrix with column pivoting so that AP = QR. At each step the remaining column
+with the largest norm is moved to the front, which orders the diagonal of R by decreasing
+magnitude and reveals the numerical rank of the matrix, see Rank.
+*/
+func NewPivotedQR[T Float](m *Matrix[T]) (*QR[T], error) {
+	return newQR(m, true)
+}
+
+func newQR[T Float](m *Matrix[T], pivoted bool) (*QR[T], error) {
+	rows, columns := m.rows, m.columns
+	steps := min(rows, columns)
+
+	qr := make([][]T, rows)
+	for i := uint(0); i < rows; i++ {
+		qr[i] = make([]T, columns)
+		for j := uint(0); j < columns; j++ {
+			qr[i][j] = m.reader.Read(i, j)
+		}
+	}
+
+	pivot := make([]uint, columns)
+	for j := range pivot {
+		pivot[j] = uint(j)
+	}
+
+	rdiag := make([]T, steps)
+
+	for k := uint(0); k < steps; k++ {
+		if pivoted {
+			// Move the remaining column with the largest norm below row k into column k
+			p, best := k, T(-1)
+			for j := k; j < columns; j++ {
+				if n := columnNorm(qr, k, j); n > best {
+					p, best = j, n
+				}
+			}
+
+			if p != k {
+				for i := uint(0); i < rows; i++ {
+					qr[i][p], qr[i][k] = qr[i][k], qr[i][p]
+				}
+				pivot[p], pivot[k] = pivot[k], pivot[p]
+			}
+		}
+
+		norm := columnNorm(qr, k, k)
+
+		if norm != 0 {
+			// Choose the sign that avoids cancellation when forming the reflection
+			if qr[k][k] < 0 {
+				norm = -norm
+			}
+
+			for i := k; i < rows; i++ {
+				qr[i][k] /= norm
+			}
+			qr[k][k] += 1
+
+			for j := k + 1; j < columns; j++ {
+				var s T
+				for i := k; i < rows; i++ {
+					s += qr[i][k] * qr[i][j]
+				}
+				s = -s / qr[k][k]
+				for i := k; i < rows; i++ {
+					qr[i][j] += s * qr[i][k]
+				}
+			}
+		}
+
+		rdiag[k] = -norm
+	}
+
+	f := &QR[T]{
+		rows:    rows,
+		columns: columns,
+		steps:   steps,
+		qr:      qr,
+		rdiag:   rdiag,
+		pivot:   pivot,
+		pivoted: pivoted,
+	}
+
+	tolerance := T(max(rows, columns)) * machineEpsilon[T]() * abs(rdiag[0])
+
+	for k := uint(0); k < steps; k++ {
+		if abs(rdiag[k]) > tolerance {
+			f.rank++
+		} else if pivoted {
+			// The diagonal is decreasing so every later element is also below the tolerance
+			break
+		}
+	}
+
+	return f, nil
+}
+
+// The Euclidean norm of column j from row k down, computed without overflow
+func columnNorm[T Float](qr [][]T, k, j uint) T {
+	var norm float64
+	for i := k; i < uint(len(qr)); i++ {
+		norm = math.Hypot(norm, float64(qr[i][j]))
+	}
+	return T(norm)
+}
+
+// The distance from 1 to the next representable value of T
+func machineEpsilon[T Float]() T {
+	if _, size := elementKind[T](); size == 4 {
+		return T(math.Nextafter32(1, 2) - 1)
+	}
+	return T(math.Nextafter(1, 2) - 1)
+}
+
+/*
+Return the numerical rank, the number of diagonal elements of R larger than
+max(rows, columns) * epsilon * |R[0][0]|. Only reliable for a pivoted factorization.
+*/
+func (f *QR[T]) Rank() uint {
+	return f.rank
+}
+
+// Whether the columns of the matrix are linearly independent
+func (f *QR[T]) IsFullRank() bool {
+	return f.rank == f.columns
+}
+
+/*
+Return the column permutation applied during factoring. Column j of AP is column
+Pivot()[j] of the original matrix. The identity permutation when not pivoted.
+*/
+func (f *QR[T]) Pivot() []uint {
+	pivot := make([]uint, len(f.pivot))
+	copy(pivot, f.pivot)
+	return pivot
+}
+
+/*
+Return the orthogonal factor with orthonormal columns. For a matrix with more rows than
+columns this is the thin factor with one column per column of the matrix.
+*/
+func (f *QR[T]) Q() *Matrix[T] {
+	steps := f.steps
+	q, _ := NewEmptyMatrix[T](f.rows, steps)
+
+	// Apply the reflections in reverse order to the columns of the identity
+	for k := steps; k > 0; k-- {
+		c := k - 1
+		q.writer.Write(c, c, 1)
+
+		if f.qr[c][c] == 0 {
+			continue
+		}
+
+		for j := c; j < steps; j++ {
+			var s T
+			for i := c; i < f.rows; i++ {
+				s += f.qr[i][c] * q.reader.Read(i, j)
+			}
+			s = -s / f.qr[c][c]
+			for i := c; i < f.rows; i++ {
+				q.writer.Write(i, j, q.reader.Read(i, j)+s*f.qr[i][c])
+			}
+		}
+	}
+
+	return q
+}
+
+// Return the upper triangular factor, with one row per column of Q
+func (f *QR[T]) R() *Matrix[T] {
+	r, _ := NewEmptyMatrix[T](f.steps, f.columns)
+
+	for i := uint(0); i < f.steps; i++ {
+		r.writer.Write(i, i, f.rdiag[i])
+		for j := i + 1; j < f.columns; j++ {
+			r.writer.Write(i, j, f.qr[i][j])
+		}
+	}
+
+	return r
+}
+
+/*
+Return X minimising the residual ||AX - B|| where A is the decomposed matrix, solving each
+column of B as a separate system. Without pivoting the columns of A must be linearly
+independent, otherwise ErrSingularMatrix is returned. With pivoting a basic solution is
+returned that uses only the first Rank() pivoted columns, so rank deficient and wide
+matrixes can also be solved.
+*/
+func (f *QR[T]) LeastSquares(b *Matrix[T]) (*Matrix[T], error) {
+	if b.rows != f.rows {
+		return nil, ErrSolveRowMismatch
+	}
+
+	rank := f.columns
+	if f.pivoted {
+		rank = f.rank
+	} else if !f.IsFullRank() {
+		return nil, ErrSingularMatrix
+	}
+
+	x, err := NewEmptyMatrix[T](f.columns, b.columns)
+	if err != nil {
+		return nil, err
+	}
+
+	y := make([]T, f.rows)
+
+	for c := uint(0); c < b.columns; c++ {
+		for i := uint(0); i < f.rows; i++ {
+			y[i] = b.reader.Read(i, c)
+		}
+
+		// Apply the reflections to the right hand side, y = Q^T b
+		for k := uint(0); k < f.steps; k++ {
+			if f.qr[k][k] == 0 {
+				continue
+			}
+			var s T
+			for i := k; i < f.rows; i++ {
+				s += f.qr[i][k] * y[i]
+			}
+			s = -s / f.qr[k][k]
+			for i := k; i < f.rows; i++ {
+				y[i] += s * f.qr[i][k]
+			}
+		}
+
+		// Back substitution on the leading rank by rank block of R
+		for k := rank; k > 0; k-- {
+			r := k - 1
+			y[r] /= f.rdiag[r]
+			for i := uint(0); i < r; i++ {
+				y[i] -= y[r] * f.qr[i][r]
+			}
+		}
+
+		for k := uint(0); k < rank; k++ {
+			x.writer.Write(f.pivot[k], c, y[k])
+		}
+	}
+
+	return x, nil
+}
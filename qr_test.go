@@ -0,0 +1,180 @@
+package matrix
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewQR(t *testing.T) {
+	input := [][]float64{
+		{12, -51, 4},
+		{6, 167, -68},
+		{-4, 24, -41},
+		{1, 2, 3},
+	}
+
+	t.Run("it factors the matrix so that A = QR", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice(input)
+
+		qr, err := NewQR(a)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		q, r := qr.Q(), qr.R()
+		if q.Rows() != 4 || q.Columns() != 3 || r.Rows() != 3 || r.Columns() != 3 {
+			t.Fatalf("expected a 4x3 Q and 3x3 R, got %dx%d and %dx%d", q.Rows(), q.Columns(), r.Rows(), r.Columns())
+		}
+
+		product, _ := q.Multiply(r)
+		matrixesAreClose(t, product, a, 1e-10)
+
+		for i := uint(1); i < 3; i++ {
+			for j := uint(0); j < i; j++ {
+				if r.reader.Read(i, j) != 0 {
+					t.Errorf("expected R to be upper triangular, got %v at [%d %d]", r.reader.Read(i, j), i, j)
+				}
+			}
+		}
+	})
+
+	t.Run("it returns Q with orthonormal columns", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice(input)
+		qr, _ := NewQR(a)
+
+		q := qr.Q()
+		qt, _ := q.Transpose()
+		product, _ := qt.Multiply(q)
+
+		identity, _ := NewIdentityMatrix[float64](3)
+		matrixesAreClose(t, product, identity, 1e-12)
+	})
+
+	t.Run("it factors a wide matrix", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice([][]float32{
+			{1, 2, 3},
+			{4, 5, 6},
+		})
+		qr, _ := NewQR(a)
+
+		product, _ := qr.Q().Multiply(qr.R())
+		matrixesAreClose(t, product, a, 1e-5)
+	})
+}
+
+func TestNewPivotedQR(t *testing.T) {
+	// The third column is the sum of the first two
+	input := [][]float64{
+		{1, 0, 1},
+		{0, 1, 1},
+		{1, 1, 2},
+		{2, 0, 2},
+	}
+
+	t.Run("it factors the matrix so that AP = QR", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice(input)
+
+		qr, err := NewPivotedQR(a)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		permuted, _ := a.Clone()
+		permuted.Permute(nil, qr.Pivot())
+
+		product, _ := qr.Q().Multiply(qr.R())
+		matrixesAreClose(t, product, permuted, 1e-12)
+
+		if qr.Pivot()[0] != 2 {
+			t.Errorf("expected the column with the largest norm first, got %v", qr.Pivot())
+		}
+	})
+
+	t.Run("it reveals the rank", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice(input)
+
+		qr, _ := NewPivotedQR(a)
+		if qr.Rank() != 2 || qr.IsFullRank() {
+			t.Errorf("expected rank 2, got %d", qr.Rank())
+		}
+
+		identity, _ := NewIdentityMatrix[float64](3)
+		qr, _ = NewPivotedQR(identity)
+		if qr.Rank() != 3 || !qr.IsFullRank() {
+			t.Errorf("expected rank 3, got %d", qr.Rank())
+		}
+	})
+}
+
+func TestQRLeastSquares(t *testing.T) {
+	t.Run("it solves an overdetermined system exactly when consistent", func(t *testing.T) {
+		// Points on the line y = 1 + 2x
+		a, _ := NewMatrixFromSlice([][]float64{{1, 0}, {1, 1}, {1, 2}, {1, 3}})
+		b, _ := NewMatrixFromSlice([][]float64{{1}, {3}, {5}, {7}})
+
+		qr, _ := NewQR(a)
+		x, err := qr.LeastSquares(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := NewMatrixFromSlice([][]float64{{1}, {2}})
+		matrixesAreClose(t, x, want, 1e-12)
+	})
+
+	t.Run("it matches the normal equations for an inconsistent system", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice([][]float64{{1, 0}, {1, 1}, {1, 2}, {1, 3}, {1, 4}})
+		b, _ := NewMatrixFromSlice([][]float64{{1, -2}, {2.5, 0}, {5.5, 1}, {6, 5}, {9.2, 4}})
+
+		qr, _ := NewQR(a)
+		got, err := qr.LeastSquares(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Solve A^T A x = A^T b
+		at, _ := a.Transpose()
+		ata, _ := at.Multiply(a)
+		atb, _ := at.Multiply(b)
+		lu, _ := NewLU(ata)
+		want, _ := lu.Solve(atb)
+
+		matrixesAreClose(t, got, want, 1e-10)
+	})
+
+	t.Run("it returns a basic solution for a rank deficient matrix when pivoted", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice([][]float64{
+			{1, 2},
+			{2, 4},
+			{3, 6},
+		})
+		b, _ := NewMatrixFromSlice([][]float64{{2}, {4}, {6}})
+
+		unpivoted, _ := NewQR(a)
+		_, err := unpivoted.LeastSquares(b)
+		if !errors.Is(err, ErrSingularMatrix) {
+			t.Errorf("expected ErrSingularMatrix, got %v", err)
+		}
+
+		pivoted, _ := NewPivotedQR(a)
+		x, err := pivoted.LeastSquares(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Only the second column is used
+		want, _ := NewMatrixFromSlice([][]float64{{0}, {1}})
+		matrixesAreClose(t, x, want, 1e-12)
+	})
+
+	t.Run("it returns an error if the row counts do not match", func(t *testing.T) {
+		a, _ := NewEmptyMatrix[float64](3, 2)
+		b, _ := NewEmptyMatrix[float64](2, 1)
+
+		qr, _ := NewQR(a)
+		_, err := qr.LeastSquares(b)
+		if !errors.Is(err, ErrSolveRowMismatch) {
+			t.Errorf("expected ErrSolveRowMismatch, got %v", err)
+		}
+	})
+}
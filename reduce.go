@@ -0,0 +1,411 @@
+package matrix
+
+/*
+Call fn for every non zero element of the matrix in row major order and return the number
+of zero elements skipped.
+*/
+func forEachNonZero[T Element](m *Matrix[T], fn func(i, j uint, value T)) uint {
+	var nonZeros uint
+
+	for i := uint(0); i < m.rows; i++ {
+		forEachNonZeroInRow(m, i, func(j uint, v T) {
+			nonZeros++
+			fn(i, j, v)
+		})
+	}
+
+	return m.Size() - nonZeros
+}
+
+/*
+Return the sum of every element. Integer sums wrap on overflow, see SumFloat64.
+*/
+func (m Matrix[T]) Sum() T {
+	var sum T
+	forEachNonZero(&m, func(i, j uint, v T) {
+		sum += v
+	})
+	return sum
+}
+
+/*
+Return the sum of every element accumulated in float64, which avoids the overflow of
+Sum for integer element types at the cost of precision above 2^53.
+*/
+func (m Matrix[T]) SumFloat64() float64 {
+	var sum float64
+	forEachNonZero(&m, func(i, j uint, v T) {
+		sum += float64(v)
+	})
+	return sum
+}
+
+/*
+Return the product of every element. Integer products wrap on overflow, see
+ProductFloat64.
+*/
+func (m Matrix[T]) Product() T {
+	product := T(1)
+	zeros := forEachNonZero(&m, func(i, j uint, v T) {
+		product *= v
+	})
+
+	// Multiplying rather than returning 0 keeps NaN and Inf propagating for floats
+	if zeros > 0 {
+		product *= 0
+	}
+	return product
+}
+
+/*
+Return the product of every element accumulated in float64.
+*/
+func (m Matrix[T]) ProductFloat64() float64 {
+	product := 1.0
+	zeros := forEachNonZero(&m, func(i, j uint, v T) {
+		product *= float64(v)
+	})
+
+	if zeros > 0 {
+		product *= 0
+	}
+	return product
+}
+
+/*
+Return the smallest element. NaN is ignored unless every element is NaN. Uses the index
+when it is an OrderedIndex.
+*/
+func (m Matrix[T]) Min() T {
+	l, ok := m.ArgMin()
+	if !ok {
+		return m.reader.Read(0, 0)
+	}
+	return l.value
+}
+
+/*
+Return the largest element. NaN is ignored unless every element is NaN. Uses the index
+when it is an OrderedIndex.
+*/
+func (m Matrix[T]) Max() T {
+	l, ok := m.ArgMax()
+	if !ok {
+		return m.reader.Read(0, 0)
+	}
+	return l.value
+}
+
+/*
+Return the arithmetic mean of the elements, accumulated in float64 for every element type.
+*/
+func (m Matrix[T]) Mean() float64 {
+	return m.SumFloat64() / float64(m.Size())
+}
+
+/*
+Return the population variance of the elements, the mean squared distance from Mean.
+Computed in float64 using two passes for accuracy.
+*/
+func (m Matrix[T]) Variance() float64 {
+	mean := m.Mean()
+
+	var sum float64
+	zeros := forEachNonZero(&m, func(i, j uint, v T) {
+		d := float64(v) - mean
+		sum += d * d
+	})
+	sum += float64(zeros) * mean * mean
+
+	return sum / float64(m.Size())
+}
+
+/*
+Return the sum of each row as a column vector with a row for every row of the matrix.
+*/
+func (m Matrix[T]) SumRows() (*Matrix[T], error) {
+	result, err := NewEmptyMatrix[T](m.rows, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := uint(0); i < m.rows; i++ {
+		var sum T
+		forEachNonZeroInRow(&m, i, func(j uint, v T) {
+			sum += v
+		})
+		result.writer.Write(i, 0, sum)
+	}
+
+	return result, nil
+}
+
+/*
+Return the sum of each row accumulated in float64 as a column vector.
+*/
+func (m Matrix[T]) SumRowsFloat64() (*Matrix[float64], error) {
+	result, err := NewEmptyMatrix[float64](m.rows, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := uint(0); i < m.rows; i++ {
+		var sum float64
+		forEachNonZeroInRow(&m, i, func(j uint, v T) {
+			sum += float64(v)
+		})
+		result.writer.Write(i, 0, sum)
+	}
+
+	return result, nil
+}
+
+/*
+Return the sum of each column as a row vector with a column for every column of the matrix.
+*/
+func (m Matrix[T]) SumColumns() (*Matrix[T], error) {
+	result, err := NewEmptyMatrix[T](1, m.columns)
+	if err != nil {
+		return nil, err
+	}
+
+	sums := make([]T, m.columns)
+	forEachNonZero(&m, func(i, j uint, v T) {
+		sums[j] += v
+	})
+
+	for j, sum := range sums {
+		result.writer.Write(0, uint(j), sum)
+	}
+
+	return result, nil
+}
+
+/*
+Return the sum of each column accumulated in float64 as a row vector.
+*/
+func (m Matrix[T]) SumColumnsFloat64() (*Matrix[float64], error) {
+	result, err := NewEmptyMatrix[float64](1, m.columns)
+	if err != nil {
+		return nil, err
+	}
+
+	sums := make([]float64, m.columns)
+	forEachNonZero(&m, func(i, j uint, v T) {
+		sums[j] += float64(v)
+	})
+
+	for j, sum := range sums {
+		result.writer.Write(0, uint(j), sum)
+	}
+
+	return result, nil
+}
+
+/*
+Return the sum of the main diagonal. The matrix must be square.
+*/
+func (m Matrix[T]) Trace() (T, error) {
+	var trace T
+
+	if m.rows != m.columns {
+		return trace, ErrMatrixMustBeSquare
+	}
+
+	for i := uint(0); i < m.rows; i++ {
+		trace += m.reader.Read(i, i)
+	}
+
+	return trace, nil
+}
+
+/*
+Return the sum of the main diagonal accumulated in float64. The matrix must be square.
+*/
+func (m Matrix[T]) TraceFloat64() (float64, error) {
+	if m.rows != m.columns {
+		return 0, ErrMatrixMustBeSquare
+	}
+
+	var trace float64
+	for i := uint(0); i < m.rows; i++ {
+		trace += float64(m.reader.Read(i, i))
+	}
+
+	return trace, nil
+}
+
+/*
+Return the smallest element of each row as a column vector. NaN is ignored unless every
+element of the row is NaN.
+*/
+func (m Matrix[T]) MinRows() (*Matrix[T], error) {
+	return axisVector(axisExtremes(&m, false, func(a, b T) bool { return a < b }), false)
+}
+
+/*
+Return the smallest element of each column as a row vector. NaN is ignored unless every
+element of the column is NaN.
+*/
+func (m Matrix[T]) MinColumns() (*Matrix[T], error) {
+	return axisVector(axisExtremes(&m, true, func(a, b T) bool { return a < b }), true)
+}
+
+/*
+Return the largest element of each row as a column vector. NaN is ignored unless every
+element of the row is NaN.
+*/
+func (m Matrix[T]) MaxRows() (*Matrix[T], error) {
+	return axisVector(axisExtremes(&m, false, func(a, b T) bool { return a > b }), false)
+}
+
+/*
+Return the largest element of each column as a row vector. NaN is ignored unless every
+element of the column is NaN.
+*/
+func (m Matrix[T]) MaxColumns() (*Matrix[T], error) {
+	return axisVector(axisExtremes(&m, true, func(a, b T) bool { return a > b }), true)
+}
+
+/*
+Return the mean of each row accumulated in float64 as a column vector.
+*/
+func (m Matrix[T]) MeanRows() (*Matrix[float64], error) {
+	return axisVector(axisMeans(&m, false), false)
+}
+
+/*
+Return the mean of each column accumulated in float64 as a row vector.
+*/
+func (m Matrix[T]) MeanColumns() (*Matrix[float64], error) {
+	return axisVector(axisMeans(&m, true), true)
+}
+
+/*
+Return the population variance of each row in float64 as a column vector.
+*/
+func (m Matrix[T]) VarianceRows() (*Matrix[float64], error) {
+	return axisVector(axisVariances(&m, false), false)
+}
+
+/*
+Return the population variance of each column in float64 as a row vector.
+*/
+func (m Matrix[T]) VarianceColumns() (*Matrix[float64], error) {
+	return axisVector(axisVariances(&m, true), true)
+}
+
+/*
+Call fn with every non zero element and the index of its row, or of its column when
+columns is set. Returns the number of non zeros seen in each row or column.
+*/
+func forEachNonZeroOnAxis[T Element](m *Matrix[T], columns bool, fn func(k uint, v T)) []uint {
+	size, _ := axisShape(m, columns)
+	counts := make([]uint, size)
+	forEachNonZero(m, func(i, j uint, v T) {
+		k := i
+		if columns {
+			k = j
+		}
+		counts[k]++
+		fn(k, v)
+	})
+
+	return counts
+}
+
+// The number of rows or columns, and the length of each, for an axis
+func axisShape[T Element](m *Matrix[T], columns bool) (uint, uint) {
+	if columns {
+		return m.columns, m.rows
+	}
+	return m.rows, m.columns
+}
+
+// The element of each row or column that is better than every other, ignoring NaN
+func axisExtremes[T Element](m *Matrix[T], columns bool, better func(a, b T) bool) []T {
+	size, length := axisShape(m, columns)
+	best := make([]T, size)
+	found := make([]bool, size)
+	nan := make([]T, size)
+
+	counts := forEachNonZeroOnAxis(m, columns, func(k uint, v T) {
+		if v != v {
+			nan[k] = v
+			return
+		}
+		if !found[k] || better(v, best[k]) {
+			best[k], found[k] = v, true
+		}
+	})
+
+	for k := range best {
+		// Elements that were not visited are zero
+		if counts[k] < length && (!found[k] || better(0, best[k])) {
+			best[k], found[k] = 0, true
+		}
+		if !found[k] {
+			best[k] = nan[k]
+		}
+	}
+
+	return best
+}
+
+// The mean of each row or column
+func axisMeans[T Element](m *Matrix[T], columns bool) []float64 {
+	size, length := axisShape(m, columns)
+	means := make([]float64, size)
+
+	forEachNonZeroOnAxis(m, columns, func(k uint, v T) {
+		means[k] += float64(v)
+	})
+
+	for k := range means {
+		means[k] /= float64(length)
+	}
+
+	return means
+}
+
+// The population variance of each row or column, using two passes like Variance
+func axisVariances[T Element](m *Matrix[T], columns bool) []float64 {
+	_, length := axisShape(m, columns)
+	means := axisMeans(m, columns)
+	variances := make([]float64, len(means))
+
+	counts := forEachNonZeroOnAxis(m, columns, func(k uint, v T) {
+		d := float64(v) - means[k]
+		variances[k] += d * d
+	})
+
+	for k := range variances {
+		zeros := float64(length - counts[k])
+		variances[k] = (variances[k] + zeros*means[k]*means[k]) / float64(length)
+	}
+
+	return variances
+}
+
+// Return values as a row vector when columns is set, otherwise as a column vector
+func axisVector[U Element](values []U, columns bool) (*Matrix[U], error) {
+	rows, cols := uint(len(values)), uint(1)
+	if columns {
+		rows, cols = 1, uint(len(values))
+	}
+
+	result, err := NewEmptyMatrix[U](rows, cols)
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range values {
+		if columns {
+			result.writer.Write(0, uint(k), v)
+		} else {
+			result.writer.Write(uint(k), 0, v)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,234 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestSumAndProduct(t *testing.T) {
+	input := [][]int{
+		{1, -2, 3},
+		{4, 5, -6},
+	}
+
+	dense, _ := NewMatrixFromSlice(input)
+	sparse := newSparseFromSlice(t, input)
+
+	for _, m := range []*Matrix[int]{dense, sparse} {
+		if got := m.Sum(); got != 5 {
+			t.Errorf("expected a sum of 5, got %d", got)
+		}
+		if got := m.Product(); got != 720 {
+			t.Errorf("expected a product of 720, got %d", got)
+		}
+	}
+
+	t.Run("it returns zero for the product of a matrix containing zero", func(t *testing.T) {
+		m := newSparseFromSlice(t, [][]int{{2, 0}, {3, 4}})
+
+		if got := m.Product(); got != 0 {
+			t.Errorf("expected a product of 0, got %d", got)
+		}
+	})
+
+	t.Run("it accumulates in float64 without overflow", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice([][]uint8{{200, 100}, {50, 10}})
+
+		if got := m.Sum(); got != 104 {
+			t.Errorf("expected the uint8 sum to wrap to 104, got %d", got)
+		}
+		if got := m.SumFloat64(); got != 360 {
+			t.Errorf("expected a float64 sum of 360, got %v", got)
+		}
+		if got := m.ProductFloat64(); got != 1e7 {
+			t.Errorf("expected a float64 product of 1e7, got %v", got)
+		}
+	})
+}
+
+func TestMinMax(t *testing.T) {
+	m, _ := NewMatrixFromSlice([][]float64{
+		{3, math.NaN()},
+		{-1, 7},
+	})
+
+	if got := m.Min(); got != -1 {
+		t.Errorf("expected a minimum of -1, got %v", got)
+	}
+	if got := m.Max(); got != 7 {
+		t.Errorf("expected a maximum of 7, got %v", got)
+	}
+
+	nan, _ := NewMatrixFromSlice([][]float64{{math.NaN()}})
+	if got := nan.Min(); !math.IsNaN(got) {
+		t.Errorf("expected NaN, got %v", got)
+	}
+}
+
+func TestMeanAndVariance(t *testing.T) {
+	input := [][]int{
+		{2, 4, 4, 4},
+		{5, 5, 7, 9},
+	}
+
+	dense, _ := NewMatrixFromSlice(input)
+	sparse := newSparseFromSlice(t, input)
+
+	for _, m := range []*Matrix[int]{dense, sparse} {
+		if got := m.Mean(); got != 5 {
+			t.Errorf("expected a mean of 5, got %v", got)
+		}
+		if got := m.Variance(); got != 4 {
+			t.Errorf("expected a variance of 4, got %v", got)
+		}
+	}
+
+	t.Run("it counts the implicit zeros of a sparse matrix", func(t *testing.T) {
+		m := newSparseFromSlice(t, [][]int{{0, 4}, {0, 0}})
+
+		if got := m.Mean(); got != 1 {
+			t.Errorf("expected a mean of 1, got %v", got)
+		}
+		if got := m.Variance(); got != 3 {
+			t.Errorf("expected a variance of 3, got %v", got)
+		}
+	})
+}
+
+func TestSumRowsAndColumns(t *testing.T) {
+	input := [][]int8{
+		{1, 2, 3},
+		{100, 100, 0},
+	}
+
+	t.Run("it sums each row into a column vector", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice(input)
+
+		got, err := m.SumRows()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := NewMatrixFromSlice([][]int8{{6}, {-56}})
+		matrixesAreEquivalent(t, got, want)
+
+		gotFloat, _ := m.SumRowsFloat64()
+		wantFloat, _ := NewMatrixFromSlice([][]float64{{6}, {200}})
+		matrixesAreEquivalent(t, gotFloat, wantFloat)
+	})
+
+	t.Run("it sums each column into a row vector", func(t *testing.T) {
+		m := newSparseFromSlice(t, input)
+
+		got, err := m.SumColumns()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := NewMatrixFromSlice([][]int8{{101, 102, 3}})
+		matrixesAreEquivalent(t, got, want)
+
+		gotFloat, _ := m.SumColumnsFloat64()
+		wantFloat, _ := NewMatrixFromSlice([][]float64{{101, 102, 3}})
+		matrixesAreEquivalent(t, gotFloat, wantFloat)
+	})
+}
+
+func TestAxisStatistics(t *testing.T) {
+	input := [][]float64{
+		{3, -1, 0, 2},
+		{0, 0, 0, 8},
+		{5, 5, 5, 5},
+	}
+
+	dense, _ := NewMatrixFromSlice(input)
+	sparse := newSparseFromSlice(t, input)
+
+	for _, m := range []*Matrix[float64]{dense, sparse} {
+		minRows, _ := m.MinRows()
+		want, _ := NewMatrixFromSlice([][]float64{{-1}, {0}, {5}})
+		matrixesAreEquivalent(t, minRows, want)
+
+		maxRows, _ := m.MaxRows()
+		want, _ = NewMatrixFromSlice([][]float64{{3}, {8}, {5}})
+		matrixesAreEquivalent(t, maxRows, want)
+
+		minColumns, _ := m.MinColumns()
+		want, _ = NewMatrixFromSlice([][]float64{{0, -1, 0, 2}})
+		matrixesAreEquivalent(t, minColumns, want)
+
+		maxColumns, _ := m.MaxColumns()
+		want, _ = NewMatrixFromSlice([][]float64{{5, 5, 5, 8}})
+		matrixesAreEquivalent(t, maxColumns, want)
+
+		meanRows, _ := m.MeanRows()
+		want, _ = NewMatrixFromSlice([][]float64{{1}, {2}, {5}})
+		matrixesAreEquivalent(t, meanRows, want)
+
+		meanColumns, _ := m.MeanColumns()
+		want, _ = NewMatrixFromSlice([][]float64{{8.0 / 3, 4.0 / 3, 5.0 / 3, 5}})
+		matrixesAreClose(t, meanColumns, want, 1e-12)
+
+		varianceRows, _ := m.VarianceRows()
+		want, _ = NewMatrixFromSlice([][]float64{{2.5}, {12}, {0}})
+		matrixesAreClose(t, varianceRows, want, 1e-12)
+
+		varianceColumns, _ := m.VarianceColumns()
+		want, _ = NewMatrixFromSlice([][]float64{{38.0 / 9, 62.0 / 9, 50.0 / 9, 6}})
+		matrixesAreClose(t, varianceColumns, want, 1e-12)
+	}
+
+	t.Run("it ignores NaN unless a row is entirely NaN", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice([][]float64{
+			{math.NaN(), 4, 1},
+			{math.NaN(), math.NaN(), math.NaN()},
+		})
+
+		got, _ := m.MinRows()
+		if got.reader.Read(0, 0) != 1 || !math.IsNaN(got.reader.Read(1, 0)) {
+			t.Errorf("expected [1 NaN], got [%v %v]", got.reader.Read(0, 0), got.reader.Read(1, 0))
+		}
+
+		got, _ = m.MaxColumns()
+		if !math.IsNaN(got.reader.Read(0, 0)) || got.reader.Read(0, 1) != 4 || got.reader.Read(0, 2) != 1 {
+			t.Errorf("expected [NaN 4 1], got %v", got.Flatten())
+		}
+	})
+
+	t.Run("it returns integer extremes in the element type", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice([][]uint8{{7, 200}, {3, 9}})
+
+		got, _ := m.MaxRows()
+		want, _ := NewMatrixFromSlice([][]uint8{{200}, {9}})
+		matrixesAreEquivalent(t, got, want)
+	})
+}
+
+func TestTrace(t *testing.T) {
+	t.Run("it sums the main diagonal", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice([][]uint8{
+			{200, 1},
+			{2, 100},
+		})
+
+		got, err := m.Trace()
+		if err != nil || got != 44 {
+			t.Errorf("expected the uint8 trace to wrap to 44, got %d %v", got, err)
+		}
+
+		gotFloat, err := m.TraceFloat64()
+		if err != nil || gotFloat != 300 {
+			t.Errorf("expected a float64 trace of 300, got %v %v", gotFloat, err)
+		}
+	})
+
+	t.Run("it returns an error if the matrix is not square", func(t *testing.T) {
+		m, _ := NewEmptyMatrix[int](2, 3)
+
+		_, err := m.Trace()
+		if !errors.Is(err, ErrMatrixMustBeSquare) {
+			t.Errorf("expected ErrMatrixMustBeSquare, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,272 @@
+package matrix
+
+// Copy the non zero elements of src into a freshly created dst at the given offset
+func copyInto[T Element](dst, src *Matrix[T], rowOffset, columnOffset uint) {
+	for i := uint(0); i < src.rows; i++ {
+		forEachNonZeroInRow(src, i, func(j uint, v T) {
+			dst.writer.Write(rowOffset+i, columnOffset+j, v)
+		})
+	}
+}
+
+/*
+Return a new matrix with the given dimensions holding the elements in the same row major
+order. The number of elements must not change.
+*/
+func (m Matrix[T]) Reshape(rows, columns uint) (*Matrix[T], error) {
+	if rows == 0 || columns == 0 {
+		return nil, ErrRowColumSize
+	}
+
+	if size, ok := checkedProduct(rows, columns); !ok || size != m.Size() {
+		return nil, ErrReshapeSize(m.Size(), rows, columns)
+	}
+
+	result, err := NewEmptyMatrix[T](rows, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := uint(0); i < m.rows; i++ {
+		forEachNonZeroInRow(&m, i, func(j uint, v T) {
+			h := i*m.columns + j
+			result.writer.Write(h/columns, h%columns, v)
+		})
+	}
+
+	return result, nil
+}
+
+/*
+Join matrixes side by side into a new matrix. Every matrix must have the same number of
+rows.
+*/
+func HStack[T Element](matrixes ...*Matrix[T]) (*Matrix[T], error) {
+	if len(matrixes) == 0 {
+		return nil, ErrRowColumSize
+	}
+
+	rows := matrixes[0].rows
+	var columns uint
+
+	for h, m := range matrixes {
+		if m.rows != rows {
+			return nil, ErrStackRowMismatch(h, rows, m.rows)
+		}
+
+		var ok bool
+		columns, ok = checkedSum(columns, m.columns)
+		if !ok {
+			return nil, ErrMatrixTooLarge
+		}
+	}
+
+	result, err := NewEmptyMatrix[T](rows, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	var offset uint
+	for _, m := range matrixes {
+		copyInto(result, m, 0, offset)
+		offset += m.columns
+	}
+
+	return result, nil
+}
+
+/*
+Join matrixes one above the other into a new matrix. Every matrix must have the same
+number of columns.
+*/
+func VStack[T Element](matrixes ...*Matrix[T]) (*Matrix[T], error) {
+	if len(matrixes) == 0 {
+		return nil, ErrRowColumSize
+	}
+
+	columns := matrixes[0].columns
+	var rows uint
+
+	for h, m := range matrixes {
+		if m.columns != columns {
+			return nil, ErrStackColumnMismatch(h, columns, m.columns)
+		}
+
+		var ok bool
+		rows, ok = checkedSum(rows, m.rows)
+		if !ok {
+			return nil, ErrMatrixTooLarge
+		}
+	}
+
+	result, err := NewEmptyMatrix[T](rows, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	var offset uint
+	for _, m := range matrixes {
+		copyInto(result, m, offset, 0)
+		offset += m.rows
+	}
+
+	return result, nil
+}
+
+/*
+Place matrixes along the diagonal of a new matrix with zeros everywhere else. The
+matrixes may have any dimensions.
+*/
+func BlockDiag[T Element](matrixes ...*Matrix[T]) (*Matrix[T], error) {
+	var rows, columns uint
+	for _, m := range matrixes {
+		var rowsOK, columnsOK bool
+		rows, rowsOK = checkedSum(rows, m.rows)
+		columns, columnsOK = checkedSum(columns, m.columns)
+		if !rowsOK || !columnsOK {
+			return nil, ErrMatrixTooLarge
+		}
+	}
+
+	result, err := NewEmptyMatrix[T](rows, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	var rowOffset, columnOffset uint
+	for _, m := range matrixes {
+		copyInto(result, m, rowOffset, columnOffset)
+		rowOffset += m.rows
+		columnOffset += m.columns
+	}
+
+	return result, nil
+}
+
+// Check split indexes are increasing and inside the dimension, returning the part boundaries
+func splitBounds(at []uint, size uint) ([]uint, error) {
+	bounds := make([]uint, 0, len(at)+2)
+	bounds = append(bounds, 0)
+
+	for _, h := range at {
+		if h <= bounds[len(bounds)-1] || h >= size {
+			return nil, ErrSplitIndex(h, size)
+		}
+		bounds = append(bounds, h)
+	}
+
+	return append(bounds, size), nil
+}
+
+/*
+Split the matrix into new matrixes before each of the given row indexes, so SplitRows(2)
+on a 5 row matrix returns a 2 row and a 3 row matrix. The indexes must be increasing and
+between 1 and the number of rows minus 1.
+*/
+func (m Matrix[T]) SplitRows(at ...uint) ([]*Matrix[T], error) {
+	bounds, err := splitBounds(at, m.rows)
+	if err != nil {
+		return nil, err
+	}
+
+	parts := make([]*Matrix[T], 0, len(bounds)-1)
+	for h := 1; h < len(bounds); h++ {
+		part, err := m.copyRegion(bounds[h-1], bounds[h], 0, m.columns)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, part)
+	}
+
+	return parts, nil
+}
+
+/*
+Split the matrix into new matrixes before each of the given column indexes. The indexes
+must be increasing and between 1 and the number of columns minus 1.
+*/
+func (m Matrix[T]) SplitColumns(at ...uint) ([]*Matrix[T], error) {
+	bounds, err := splitBounds(at, m.columns)
+	if err != nil {
+		return nil, err
+	}
+
+	parts := make([]*Matrix[T], 0, len(bounds)-1)
+	for h := 1; h < len(bounds); h++ {
+		part, err := m.copyRegion(0, m.rows, bounds[h-1], bounds[h])
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, part)
+	}
+
+	return parts, nil
+}
+
+// Copy the half open region of rows r0 to r1 and columns c0 to c1 into a new matrix
+func (m *Matrix[T]) copyRegion(r0, r1, c0, c1 uint) (*Matrix[T], error) {
+	result, err := NewEmptyMatrix[T](r1-r0, c1-c0)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := r0; i < r1; i++ {
+		for j := c0; j < c1; j++ {
+			result.writer.Write(i-r0, j-c0, m.reader.Read(i, j))
+		}
+	}
+
+	return result, nil
+}
+
+/*
+Repeat each element into a block of rows by columns copies, so Repeat(2, 1) doubles every
+row in place.
+*/
+func (m Matrix[T]) Repeat(rows, columns uint) (*Matrix[T], error) {
+	result, err := m.scaledEmpty(rows, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := uint(0); i < m.rows; i++ {
+		forEachNonZeroInRow(&m, i, func(j uint, v T) {
+			for r := uint(0); r < rows; r++ {
+				for c := uint(0); c < columns; c++ {
+					result.writer.Write(i*rows+r, j*columns+c, v)
+				}
+			}
+		})
+	}
+
+	return result, nil
+}
+
+/*
+Repeat the whole matrix rows times vertically and columns times horizontally.
+*/
+func (m Matrix[T]) Tile(rows, columns uint) (*Matrix[T], error) {
+	result, err := m.scaledEmpty(rows, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	for r := uint(0); r < rows; r++ {
+		for c := uint(0); c < columns; c++ {
+			copyInto(result, &m, r*m.rows, c*m.columns)
+		}
+	}
+
+	return result, nil
+}
+
+// Create an empty matrix rows times as tall and columns times as wide as m
+func (m *Matrix[T]) scaledEmpty(rows, columns uint) (*Matrix[T], error) {
+	r, rowsOK := checkedProduct(m.rows, rows)
+	c, columnsOK := checkedProduct(m.columns, columns)
+	if !rowsOK || !columnsOK {
+		return nil, ErrMatrixTooLarge
+	}
+
+	return NewEmptyMatrix[T](r, c)
+}
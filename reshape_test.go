@@ -0,0 +1,233 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestReshape(t *testing.T) {
+	m, _ := NewMatrixFromSlice([][]int{
+		{1, 2, 3},
+		{4, 5, 6},
+	})
+
+	t.Run("it preserves row major order", func(t *testing.T) {
+		got, err := m.Reshape(3, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := NewMatrixFromSlice([][]int{
+			{1, 2},
+			{3, 4},
+			{5, 6},
+		})
+		matrixesAreEquivalent(t, got, want)
+	})
+
+	t.Run("it reshapes a sparse matrix", func(t *testing.T) {
+		sparse := newSparseFromSlice(t, [][]int{{0, 7}, {8, 0}})
+
+		got, _ := sparse.Reshape(1, 4)
+
+		want, _ := NewMatrixFromSlice([][]int{{0, 7, 8, 0}})
+		matrixesAreEquivalent(t, got, want)
+	})
+
+	t.Run("it returns an error if the size changes", func(t *testing.T) {
+		_, err := m.Reshape(4, 2)
+		if err == nil || err.Error() != ErrReshapeSize(6, 4, 2).Error() {
+			t.Errorf("expected %v, got %v", ErrReshapeSize(6, 4, 2), err)
+		}
+	})
+}
+
+func TestStack(t *testing.T) {
+	a, _ := NewMatrixFromSlice([][]int{{1, 2}, {3, 4}})
+	b, _ := NewMatrixFromSlice([][]int{{5}, {6}})
+	c, _ := NewMatrixFromSlice([][]int{{7, 8}})
+
+	t.Run("it stacks horizontally", func(t *testing.T) {
+		got, err := HStack(a, b, a)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := NewMatrixFromSlice([][]int{
+			{1, 2, 5, 1, 2},
+			{3, 4, 6, 3, 4},
+		})
+		matrixesAreEquivalent(t, got, want)
+	})
+
+	t.Run("it stacks vertically", func(t *testing.T) {
+		got, err := VStack(a, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := NewMatrixFromSlice([][]int{
+			{1, 2},
+			{3, 4},
+			{7, 8},
+		})
+		matrixesAreEquivalent(t, got, want)
+	})
+
+	t.Run("it places matrixes on the diagonal", func(t *testing.T) {
+		got, err := BlockDiag(a, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := NewMatrixFromSlice([][]int{
+			{1, 2, 0},
+			{3, 4, 0},
+			{0, 0, 5},
+			{0, 0, 6},
+		})
+		matrixesAreEquivalent(t, got, want)
+	})
+
+	t.Run("it reports the mismatched matrix", func(t *testing.T) {
+		_, err := HStack(a, c)
+		if err == nil || err.Error() != ErrStackRowMismatch(1, 2, 1).Error() {
+			t.Errorf("expected %v, got %v", ErrStackRowMismatch(1, 2, 1), err)
+		}
+
+		_, err = VStack(a, b)
+		if err == nil || err.Error() != ErrStackColumnMismatch(1, 2, 1).Error() {
+			t.Errorf("expected %v, got %v", ErrStackColumnMismatch(1, 2, 1), err)
+		}
+	})
+
+	t.Run("it returns ErrMatrixTooLarge when the summed shape overflows", func(t *testing.T) {
+		// Only the shape is read before the overflow is detected
+		tall := &Matrix[int]{rows: math.MaxUint/2 + 1, columns: 2}
+		wide := &Matrix[int]{rows: 2, columns: math.MaxUint/2 + 1}
+
+		_, err := HStack(wide, wide)
+		if !errors.Is(err, ErrMatrixTooLarge) {
+			t.Errorf("expected ErrMatrixTooLarge from HStack, got %v", err)
+		}
+
+		_, err = VStack(tall, tall)
+		if !errors.Is(err, ErrMatrixTooLarge) {
+			t.Errorf("expected ErrMatrixTooLarge from VStack, got %v", err)
+		}
+
+		_, err = BlockDiag(tall, tall)
+		if !errors.Is(err, ErrMatrixTooLarge) {
+			t.Errorf("expected ErrMatrixTooLarge from BlockDiag, got %v", err)
+		}
+	})
+
+	t.Run("it returns an error for no matrixes", func(t *testing.T) {
+		_, err := HStack[int]()
+		if !errors.Is(err, ErrRowColumSize) {
+			t.Errorf("expected ErrRowColumSize, got %v", err)
+		}
+	})
+}
+
+func TestSplit(t *testing.T) {
+	m, _ := NewMatrixFromSlice([][]int{
+		{1, 2, 3, 4},
+		{5, 6, 7, 8},
+		{9, 10, 11, 12},
+	})
+
+	t.Run("it splits rows before each index", func(t *testing.T) {
+		parts, err := m.SplitRows(1)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		top, _ := NewMatrixFromSlice([][]int{{1, 2, 3, 4}})
+		bottom, _ := NewMatrixFromSlice([][]int{{5, 6, 7, 8}, {9, 10, 11, 12}})
+
+		if len(parts) != 2 {
+			t.Fatalf("expected 2 parts, got %d", len(parts))
+		}
+		matrixesAreEquivalent(t, parts[0], top)
+		matrixesAreEquivalent(t, parts[1], bottom)
+	})
+
+	t.Run("it splits columns and joins back with HStack", func(t *testing.T) {
+		parts, err := m.SplitColumns(1, 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(parts) != 3 || parts[1].Columns() != 2 {
+			t.Fatalf("expected parts of 1, 2 and 1 columns, got %d parts", len(parts))
+		}
+
+		joined, _ := HStack(parts...)
+		matrixesAreEquivalent(t, joined, m)
+	})
+
+	t.Run("it returns an error for an invalid index", func(t *testing.T) {
+		for _, at := range [][]uint{{0}, {3}, {2, 1}, {1, 1}} {
+			_, err := m.SplitRows(at...)
+			if err == nil {
+				t.Errorf("expected error for %v but got none", at)
+			}
+		}
+	})
+}
+
+func TestRepeatAndTile(t *testing.T) {
+	m, _ := NewMatrixFromSlice([][]int{
+		{1, 2},
+		{3, 4},
+	})
+
+	t.Run("it repeats each element", func(t *testing.T) {
+		got, err := m.Repeat(2, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := NewMatrixFromSlice([][]int{
+			{1, 2},
+			{1, 2},
+			{3, 4},
+			{3, 4},
+		})
+		matrixesAreEquivalent(t, got, want)
+	})
+
+	t.Run("it tiles the whole matrix", func(t *testing.T) {
+		got, err := m.Tile(1, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := NewMatrixFromSlice([][]int{
+			{1, 2, 1, 2},
+			{3, 4, 3, 4},
+		})
+		matrixesAreEquivalent(t, got, want)
+	})
+
+	t.Run("it returns an error for zero repeats", func(t *testing.T) {
+		_, err := m.Tile(0, 2)
+		if !errors.Is(err, ErrRowColumSize) {
+			t.Errorf("expected ErrRowColumSize, got %v", err)
+		}
+	})
+
+	t.Run("it returns ErrMatrixTooLarge when the shape overflows", func(t *testing.T) {
+		_, err := m.Repeat(math.MaxUint/2+1, 1)
+		if !errors.Is(err, ErrMatrixTooLarge) {
+			t.Errorf("expected ErrMatrixTooLarge from Repeat, got %v", err)
+		}
+
+		_, err = m.Tile(1, math.MaxUint/2+1)
+		if !errors.Is(err, ErrMatrixTooLarge) {
+			t.Errorf("expected ErrMatrixTooLarge from Tile, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,311 @@
+package matrix
+
+/*
+Swap two rows of the matrix in place. The index is kept in sync.
+*/
+func (m *Matrix[T]) SwapRows(i1, i2 uint) (*Matrix[T], error) {
+	if i1 >= m.rows || i2 >= m.rows {
+		return nil, ErrMatrixOutOfBounds
+	}
+
+	if err := m.writable(); err != nil {
+		return nil, err
+	}
+
+	if i1 == i2 {
+		return m, nil
+	}
+
+	for j := uint(0); j < m.columns; j++ {
+		a, b := m.reader.Read(i1, j), m.reader.Read(i2, j)
+		if a != b {
+			m.write(i1, j, b)
+			m.write(i2, j, a)
+		}
+	}
+
+	return m, nil
+}
+
+/*
+Swap two columns of the matrix in place. The index is kept in sync.
+*/
+func (m *Matrix[T]) SwapColumns(j1, j2 uint) (*Matrix[T], error) {
+	if j1 >= m.columns || j2 >= m.columns {
+		return nil, ErrMatrixOutOfBounds
+	}
+
+	if err := m.writable(); err != nil {
+		return nil, err
+	}
+
+	if j1 == j2 {
+		return m, nil
+	}
+
+	for i := uint(0); i < m.rows; i++ {
+		a, b := m.reader.Read(i, j1), m.reader.Read(i, j2)
+		if a != b {
+			m.write(i, j1, b)
+			m.write(i, j2, a)
+		}
+	}
+
+	return m, nil
+}
+
+/*
+Reorder the rows and columns of the matrix in place so that row i becomes the old row
+rowPerm[i] and column j the old column colPerm[j]. A nil permutation leaves that
+dimension unchanged. Each permutation must contain every index exactly once.
+*/
+func (m *Matrix[T]) Permute(rowPerm, colPerm []uint) (*Matrix[T], error) {
+	err := validatePermutation(rowPerm, m.rows)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validatePermutation(colPerm, m.columns)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.writable()
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := m.Clone()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := uint(0); i < m.rows; i++ {
+		fromRow := i
+		if rowPerm != nil {
+			fromRow = rowPerm[i]
+		}
+
+		for j := uint(0); j < m.columns; j++ {
+			fromColumn := j
+			if colPerm != nil {
+				fromColumn = colPerm[j]
+			}
+
+			m.write(i, j, source.reader.Read(fromRow, fromColumn))
+		}
+	}
+
+	return m, nil
+}
+
+func validatePermutation(perm []uint, size uint) error {
+	if perm == nil {
+		return nil
+	}
+
+	if uint(len(perm)) != size {
+		return ErrInvalidPermutation
+	}
+
+	seen := make([]bool, size)
+	for _, h := range perm {
+		if h >= size {
+			return ErrMatrixOutOfBounds
+		}
+		if seen[h] {
+			return ErrInvalidPermutation
+		}
+		seen[h] = true
+	}
+
+	return nil
+}
+
+/*
+Insert a row before row i, or after the last row when i equals the number of rows. The
+values must have one element per column. The data store is replaced, see restructure.
+*/
+func (m *Matrix[T]) InsertRow(i uint, values []T) (*Matrix[T], error) {
+	if i > m.rows {
+		return nil, ErrMatrixOutOfBounds
+	}
+
+	if uint(len(values)) != m.columns {
+		return nil, ErrVectorLength(m.columns, uint(len(values)))
+	}
+
+	err := m.restructure(m.rows+1, m.columns, func(r, c uint) (uint, uint, bool) {
+		if r >= i {
+			r++
+		}
+		return r, c, true
+	}, func(write func(r, c uint, v T)) {
+		for c, v := range values {
+			write(i, uint(c), v)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+/*
+Remove row i. A matrix with a single row cannot have it removed. The data store is
+replaced, see restructure.
+*/
+func (m *Matrix[T]) DeleteRow(i uint) (*Matrix[T], error) {
+	if i >= m.rows {
+		return nil, ErrMatrixOutOfBounds
+	}
+
+	if m.rows == 1 {
+		return nil, ErrRowColumSize
+	}
+
+	err := m.restructure(m.rows-1, m.columns, func(r, c uint) (uint, uint, bool) {
+		if r == i {
+			return 0, 0, false
+		}
+		if r > i {
+			r--
+		}
+		return r, c, true
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+/*
+Insert a column before column j, or after the last column when j equals the number of
+columns. The values must have one element per row. The data store is replaced, see
+restructure.
+*/
+func (m *Matrix[T]) InsertColumn(j uint, values []T) (*Matrix[T], error) {
+	if j > m.columns {
+		return nil, ErrMatrixOutOfBounds
+	}
+
+	if uint(len(values)) != m.rows {
+		return nil, ErrVectorLength(m.rows, uint(len(values)))
+	}
+
+	err := m.restructure(m.rows, m.columns+1, func(r, c uint) (uint, uint, bool) {
+		if c >= j {
+			c++
+		}
+		return r, c, true
+	}, func(write func(r, c uint, v T)) {
+		for r, v := range values {
+			write(uint(r), j, v)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+/*
+Remove column j. A matrix with a single column cannot have it removed. The data store
+is replaced, see restructure.
+*/
+func (m *Matrix[T]) DeleteColumn(j uint) (*Matrix[T], error) {
+	if j >= m.columns {
+		return nil, ErrMatrixOutOfBounds
+	}
+
+	if m.columns == 1 {
+		return nil, ErrRowColumSize
+	}
+
+	err := m.restructure(m.rows, m.columns-1, func(r, c uint) (uint, uint, bool) {
+		if c == j {
+			return 0, 0, false
+		}
+		if c > j {
+			c--
+		}
+		return r, c, true
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+/*
+Move the matrix to a new data store with a different shape. Every non zero element at
+(i, j) is moved to the position returned by move unless it returns false, then insert
+writes any new elements. Sparse matrixes stay sparse, others move to a DenseStore. The
+matrix stops using the store it was created with, so a store owned by the caller is no
+longer updated and a matrix that was itself a view no longer shares data with its
+parent. Views over the matrix become stale, see ViewStore. The index is rebuilt.
+*/
+func (m *Matrix[T]) restructure(rows, columns uint, move func(i, j uint) (uint, uint, bool), insert func(write func(i, j uint, v T))) error {
+	if err := m.writable(); err != nil {
+		return err
+	}
+
+	var reader DataReader[T]
+	var writer DataWriter[T]
+	var write func(i, j uint, v T)
+
+	var builder *COOBuilder[T]
+
+	if isSparse(m) {
+		b, err := NewCOOBuilder[T](rows, columns)
+		if err != nil {
+			return err
+		}
+		builder = b
+		write = func(i, j uint, v T) { builder.Add(i, j, v) }
+	} else {
+		store, err := NewDenseStore[T](rows, columns)
+		if err != nil {
+			return err
+		}
+		reader, writer = store, store
+		write = store.Write
+	}
+
+	for i := uint(0); i < m.rows; i++ {
+		forEachNonZeroInRow(m, i, func(j uint, v T) {
+			r, c, ok := move(i, j)
+			if ok {
+				write(r, c, v)
+			}
+		})
+	}
+
+	if insert != nil {
+		insert(write)
+	}
+
+	if builder != nil {
+		store := builder.CSR()
+		reader, writer = store, store
+	}
+
+	kind, indexed := HashIndex, m.HasIndex()
+	if m.ordered != nil {
+		kind = OrderedIndex
+	}
+
+	m.DropIndex()
+	m.rows, m.columns = rows, columns
+	m.reader, m.writer = reader, writer
+	m.generation++
+
+	if indexed {
+		return m.Index(kind)
+	}
+	return nil
+}
@@ -0,0 +1,240 @@
+package matrix
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSwap(t *testing.T) {
+	t.Run("it swaps rows and keeps the index in sync", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice([][]int{
+			{1, 2},
+			{3, 4},
+			{5, 6},
+		})
+		m.Index()
+
+		_, err := m.SwapRows(0, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := NewMatrixFromSlice([][]int{
+			{5, 6},
+			{3, 4},
+			{1, 2},
+		})
+		want.Index()
+		matrixesAreEqual(t, m, want)
+		assertIndexMatchesScan(t, m)
+	})
+
+	t.Run("it swaps columns", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice([][]int{
+			{1, 2, 3},
+			{4, 5, 6},
+		})
+
+		m.SwapColumns(2, 0)
+
+		want, _ := NewMatrixFromSlice([][]int{
+			{3, 2, 1},
+			{6, 5, 4},
+		})
+		matrixesAreEqual(t, m, want)
+	})
+
+	t.Run("it returns an error for an invalid position", func(t *testing.T) {
+		m, _ := NewEmptyMatrix[int](2, 2)
+
+		_, err := m.SwapRows(0, 2)
+		if !errors.Is(err, ErrMatrixOutOfBounds) {
+			t.Errorf("expected ErrMatrixOutOfBounds, got %v", err)
+		}
+
+		_, err = m.SwapColumns(5, 0)
+		if !errors.Is(err, ErrMatrixOutOfBounds) {
+			t.Errorf("expected ErrMatrixOutOfBounds, got %v", err)
+		}
+	})
+}
+
+func TestPermute(t *testing.T) {
+	t.Run("it reorders rows and columns", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice([][]int{
+			{1, 2, 3},
+			{4, 5, 6},
+		})
+		m.Index(OrderedIndex)
+
+		_, err := m.Permute([]uint{1, 0}, []uint{2, 0, 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := NewMatrixFromSlice([][]int{
+			{6, 4, 5},
+			{3, 1, 2},
+		})
+		elementsAreEqual(t, m, want)
+		assertOrderedIndexMatchesScan(t, m, 2, 5)
+	})
+
+	t.Run("it leaves a dimension with a nil permutation unchanged", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice([][]int{
+			{1, 2},
+			{3, 4},
+		})
+
+		m.Permute(nil, []uint{1, 0})
+
+		want, _ := NewMatrixFromSlice([][]int{
+			{2, 1},
+			{4, 3},
+		})
+		matrixesAreEqual(t, m, want)
+	})
+
+	t.Run("it returns an error for an invalid permutation", func(t *testing.T) {
+		m, _ := NewEmptyMatrix[int](2, 2)
+
+		_, err := m.Permute([]uint{0, 0}, nil)
+		if !errors.Is(err, ErrInvalidPermutation) {
+			t.Errorf("expected ErrInvalidPermutation, got %v", err)
+		}
+
+		_, err = m.Permute([]uint{0}, nil)
+		if !errors.Is(err, ErrInvalidPermutation) {
+			t.Errorf("expected ErrInvalidPermutation, got %v", err)
+		}
+
+		_, err = m.Permute(nil, []uint{0, 2})
+		if !errors.Is(err, ErrMatrixOutOfBounds) {
+			t.Errorf("expected ErrMatrixOutOfBounds, got %v", err)
+		}
+	})
+}
+
+func TestInsertDelete(t *testing.T) {
+	input := func() [][]int {
+		return [][]int{
+			{1, 2},
+			{3, 4},
+		}
+	}
+
+	t.Run("it inserts and deletes rows", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice(input())
+		m.Index()
+
+		_, err := m.InsertRow(1, []int{7, 8})
+		if err != nil {
+			t.Fatal(err)
+		}
+		m.InsertRow(3, []int{9, 0})
+
+		want, _ := NewMatrixFromSlice([][]int{
+			{1, 2},
+			{7, 8},
+			{3, 4},
+			{9, 0},
+		})
+		want.Index()
+		matrixesAreEquivalent(t, m, want)
+
+		m.DeleteRow(0)
+
+		want, _ = NewMatrixFromSlice([][]int{
+			{7, 8},
+			{3, 4},
+			{9, 0},
+		})
+		want.Index()
+		matrixesAreEquivalent(t, m, want)
+	})
+
+	t.Run("it inserts and deletes columns of a sparse matrix", func(t *testing.T) {
+		m := newSparseFromSlice(t, [][]int{
+			{1, 0},
+			{0, 4},
+		})
+
+		m.InsertColumn(0, []int{5, 0})
+		m.DeleteColumn(1)
+
+		want, _ := NewMatrixFromSlice([][]int{
+			{5, 0},
+			{0, 4},
+		})
+		elementsAreEqual(t, m, want)
+
+		if !isSparse(m) {
+			t.Error("expected the matrix to remain sparse")
+		}
+	})
+
+	t.Run("it invalidates existing views", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice([][]int{
+			{1, 2},
+			{3, 4},
+			{5, 6},
+		})
+
+		view, _ := m.Row(1)
+		inner, _ := view.Slice(0, 1, 0, 1)
+		m.DeleteRow(0)
+
+		_, err := view.Set(0, 0, 9)
+		if !errors.Is(err, ErrStaleView) {
+			t.Errorf("expected ErrStaleView, got %v", err)
+		}
+
+		_, err = inner.Fill(9)
+		if !errors.Is(err, ErrStaleView) {
+			t.Errorf("expected ErrStaleView for a view of the view, got %v", err)
+		}
+
+		_, err = view.Slice(0, 1, 0, 1)
+		if !errors.Is(err, ErrStaleView) {
+			t.Errorf("expected ErrStaleView when slicing, got %v", err)
+		}
+
+		want, _ := NewMatrixFromSlice([][]int{
+			{3, 4},
+			{5, 6},
+		})
+		elementsAreEqual(t, m, want)
+
+		defer func() {
+			if recover() != ErrStaleView {
+				t.Error("expected reading a stale view to panic with ErrStaleView")
+			}
+		}()
+		view.Flatten()
+	})
+
+	t.Run("it returns errors for invalid arguments", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice(input())
+
+		_, err := m.InsertRow(3, []int{1, 2})
+		if !errors.Is(err, ErrMatrixOutOfBounds) {
+			t.Errorf("expected ErrMatrixOutOfBounds, got %v", err)
+		}
+
+		_, err = m.InsertColumn(0, []int{1})
+		if err == nil || err.Error() != ErrVectorLength(2, 1).Error() {
+			t.Errorf("expected %v, got %v", ErrVectorLength(2, 1), err)
+		}
+
+		_, err = m.DeleteColumn(2)
+		if !errors.Is(err, ErrMatrixOutOfBounds) {
+			t.Errorf("expected ErrMatrixOutOfBounds, got %v", err)
+		}
+
+		single, _ := NewEmptyMatrix[int](1, 3)
+		_, err = single.DeleteRow(0)
+		if !errors.Is(err, ErrRowColumSize) {
+			t.Errorf("expected ErrRowColumSize, got %v", err)
+		}
+	})
+}
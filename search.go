@@ -0,0 +1,203 @@
+package matrix
+
+import "sort"
+
+/*
+Search the matrix for every element matching the predicate. Returns the locations in
+row major order and a bool that is false when nothing matched. With an index the
+predicate is called once per distinct value rather than once per element.
+*/
+func (m Matrix[T]) SearchFunc(pred func(T) bool) ([]Location[T], bool) {
+	var found []Location[T]
+
+	if m.ordered != nil {
+		// Equal values are adjacent so the predicate is called once for each run
+		for h := 0; h < len(m.ordered); {
+			v := m.ordered[h].value
+			end := h + 1
+			for end < len(m.ordered) && !valueLess(v, m.ordered[end].value) {
+				end++
+			}
+			if pred(v) {
+				for _, e := range m.ordered[h:end] {
+					found = append(found, Location[T]{position: e.position, value: e.value})
+				}
+			}
+			h = end
+		}
+
+		sortLocations(found)
+		return found, len(found) > 0
+	}
+
+	if m.HasIndex() {
+		for v, positions := range m.index {
+			if !pred(v) {
+				continue
+			}
+			for _, p := range positions {
+				found = append(found, Location[T]{position: p, value: v})
+			}
+		}
+
+		if len(m.nans) > 0 {
+			if v := m.reader.Read(m.nans[0][0], m.nans[0][1]); pred(v) {
+				for _, p := range m.nans {
+					found = append(found, Location[T]{position: p, value: v})
+				}
+			}
+		}
+
+		sortLocations(found)
+		return found, len(found) > 0
+	}
+
+	// Zero is the only value in the gaps so the stored elements are enough when it does not match
+	if s, ok := sparseReader(&m); ok && !pred(0) {
+		s.ForEachNonZero(func(i, j uint, v T) {
+			if pred(v) {
+				found = append(found, Location[T]{position: [2]uint{i, j}, value: v})
+			}
+		})
+		return found, len(found) > 0
+	}
+
+	for i := uint(0); i < m.rows; i++ {
+		for j := uint(0); j < m.columns; j++ {
+			v := m.reader.Read(i, j)
+			if pred(v) {
+				found = append(found, Location[T]{position: [2]uint{i, j}, value: v})
+			}
+		}
+	}
+
+	return found, len(found) > 0
+}
+
+/*
+Search the matrix for every element between lo and hi inclusive. Returns the locations
+in row major order and a bool that is false when nothing matched.
+*/
+func (m Matrix[T]) SearchRange(lo, hi T) ([]Location[T], bool) {
+	if m.ordered != nil {
+		found := m.orderedRange(lo, hi)
+		return found, len(found) > 0
+	}
+
+	return m.SearchFunc(func(v T) bool {
+		return v >= lo && v <= hi
+	})
+}
+
+/*
+Return the number of elements in the matrix equal to element.
+*/
+func (m Matrix[T]) Count(element T) uint {
+	if m.ordered != nil {
+		if element != element {
+			return 0
+		}
+		return uint(m.orderedUpperBound(element) - m.orderedLowerBound(element))
+	}
+
+	if m.HasIndex() {
+		return uint(len(m.index[element]))
+	}
+
+	if s, ok := sparseReader(&m); ok {
+		var count, stored uint
+		s.ForEachNonZero(func(i, j uint, v T) {
+			stored++
+			if v == element {
+				count++
+			}
+		})
+		if element == 0 {
+			count += m.Size() - stored
+		}
+		return count
+	}
+
+	var count uint
+	for i := uint(0); i < m.rows; i++ {
+		for j := uint(0); j < m.columns; j++ {
+			if m.reader.Read(i, j) == element {
+				count++
+			}
+		}
+	}
+
+	return count
+}
+
+/*
+Return the location of the smallest element. Ties are resolved to the first location in
+row major order and NaN is ignored. The bool is false if there is no element to compare.
+*/
+func (m Matrix[T]) ArgMin() (Location[T], bool) {
+	if m.ordered != nil {
+		if m.orderedCount() == 0 {
+			return Location[T]{}, false
+		}
+		e := m.ordered[0]
+		return Location[T]{position: e.position, value: e.value}, true
+	}
+
+	return m.argBest(func(a, b T) bool { return a < b })
+}
+
+/*
+Return the location of the largest element. Ties are resolved to the first location in
+row major order and NaN is ignored. The bool is false if there is no element to compare.
+*/
+func (m Matrix[T]) ArgMax() (Location[T], bool) {
+	if m.ordered != nil {
+		n := m.orderedCount()
+		if n == 0 {
+			return Location[T]{}, false
+		}
+		e := m.ordered[m.orderedLowerBound(m.ordered[n-1].value)]
+		return Location[T]{position: e.position, value: e.value}, true
+	}
+
+	return m.argBest(func(a, b T) bool { return a > b })
+}
+
+// Find the first location whose element is better than every other element
+func (m Matrix[T]) argBest(better func(a, b T) bool) (Location[T], bool) {
+	var best Location[T]
+	found := false
+
+	consider := func(v T, position [2]uint) {
+		// NaN never equals itself and would make every later comparison false
+		if v != v {
+			return
+		}
+		if !found || better(v, best.value) {
+			best = Location[T]{position: position, value: v}
+			found = true
+		}
+	}
+
+	if m.HasIndex() {
+		for v, positions := range m.index {
+			consider(v, positions[0])
+		}
+		return best, found
+	}
+
+	for i := uint(0); i < m.rows; i++ {
+		for j := uint(0); j < m.columns; j++ {
+			consider(m.reader.Read(i, j), [2]uint{i, j})
+		}
+	}
+
+	return best, found
+}
+
+// Sort locations into row major order
+func sortLocations[T Element](found []Location[T]) {
+	sort.Slice(found, func(a, b int) bool {
+		return positionLess(found[a].position, found[b].position)
+	})
+}
@@ -0,0 +1,183 @@
+package matrix
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestLocationAccessors(t *testing.T) {
+	m, _ := NewMatrixFromSlice([][]int{
+		{1, 2},
+		{3, 4},
+	})
+
+	s, _ := m.Search(3)
+	if s[0].Row() != 1 || s[0].Column() != 0 || s[0].Value() != 3 {
+		t.Errorf("expected 3 at [1 0], got %v at [%d %d]", s[0].Value(), s[0].Row(), s[0].Column())
+	}
+}
+
+func TestSearchFunc(t *testing.T) {
+	input := [][]int{
+		{5, -2, 0},
+		{7, 5, -9},
+		{0, 1, 5},
+	}
+
+	t.Run("it returns matching locations in row major order", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice(input)
+
+		got, found := m.SearchFunc(func(v int) bool { return v < 0 })
+		if !found {
+			t.Fatal("expected found to be true, got false")
+		}
+
+		want := []Location[int]{
+			{position: [2]uint{0, 1}, value: -2},
+			{position: [2]uint{1, 2}, value: -9},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("it returns false when nothing matches", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice(input)
+
+		_, found := m.SearchFunc(func(v int) bool { return v > 100 })
+		if found {
+			t.Error("expected found to be false, got true")
+		}
+	})
+
+	t.Run("it calls the predicate once per value when indexed", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice(input)
+		m.Index()
+
+		calls := 0
+		m.SearchFunc(func(v int) bool {
+			calls++
+			return true
+		})
+
+		if calls != 6 {
+			t.Errorf("expected 6 calls, got %d", calls)
+		}
+	})
+
+	t.Run("it returns the same locations indexed, sparse and scanned", func(t *testing.T) {
+		predicates := map[string]func(int) bool{
+			"positive": func(v int) bool { return v > 0 },
+			"zero":     func(v int) bool { return v == 0 },
+			"odd":      func(v int) bool { return v%2 != 0 },
+		}
+
+		for name, pred := range predicates {
+			scanned, _ := NewMatrixFromSlice(input)
+			want, _ := scanned.SearchFunc(pred)
+
+			indexed, _ := NewMatrixFromSlice(input)
+			indexed.Index()
+			got, _ := indexed.SearchFunc(pred)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("%s indexed: expected %v, got %v", name, want, got)
+			}
+
+			sparse := newSparseFromSlice(t, input)
+			got, _ = sparse.SearchFunc(pred)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("%s sparse: expected %v, got %v", name, want, got)
+			}
+		}
+	})
+}
+
+func TestSearchRange(t *testing.T) {
+	m, _ := NewMatrixFromSlice([][]float64{
+		{0.5, 1.5, 2.5},
+		{1, math.NaN(), 2},
+	})
+
+	got, _ := m.SearchRange(1, 2)
+
+	want := []Location[float64]{
+		{position: [2]uint{0, 1}, value: 1.5},
+		{position: [2]uint{1, 0}, value: 1},
+		{position: [2]uint{1, 2}, value: 2},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestCount(t *testing.T) {
+	input := [][]int{
+		{0, 3, 0},
+		{3, 0, 1},
+	}
+
+	scanned, _ := NewMatrixFromSlice(input)
+	indexed, _ := NewMatrixFromSlice(input)
+	indexed.Index()
+	sparse := newSparseFromSlice(t, input)
+
+	for _, m := range []*Matrix[int]{scanned, indexed, sparse} {
+		if got := m.Count(0); got != 3 {
+			t.Errorf("expected 3 zeros, got %d", got)
+		}
+		if got := m.Count(3); got != 2 {
+			t.Errorf("expected 2 threes, got %d", got)
+		}
+		if got := m.Count(7); got != 0 {
+			t.Errorf("expected no sevens, got %d", got)
+		}
+	}
+}
+
+func TestArgMinArgMax(t *testing.T) {
+	input := [][]int{
+		{4, -1, 9},
+		{9, -1, 0},
+	}
+
+	t.Run("it returns the first location of the extreme values", func(t *testing.T) {
+		scanned, _ := NewMatrixFromSlice(input)
+		indexed, _ := NewMatrixFromSlice(input)
+		indexed.Index()
+
+		for _, m := range []*Matrix[int]{scanned, indexed} {
+			min, ok := m.ArgMin()
+			if !ok || min.Row() != 0 || min.Column() != 1 || min.Value() != -1 {
+				t.Errorf("expected -1 at [0 1], got %v", min)
+			}
+
+			max, ok := m.ArgMax()
+			if !ok || max.Row() != 0 || max.Column() != 2 || max.Value() != 9 {
+				t.Errorf("expected 9 at [0 2], got %v", max)
+			}
+		}
+	})
+
+	t.Run("it ignores NaN", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice([][]float64{{math.NaN(), 2, 1}})
+
+		min, _ := m.ArgMin()
+		if min.Column() != 2 {
+			t.Errorf("expected the minimum at column 2, got %d", min.Column())
+		}
+
+		max, _ := m.ArgMax()
+		if max.Column() != 1 {
+			t.Errorf("expected the maximum at column 1, got %d", max.Column())
+		}
+	})
+
+	t.Run("it returns false if every element is NaN", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice([][]float64{{math.NaN()}})
+
+		if _, ok := m.ArgMax(); ok {
+			t.Error("expected ok to be false, got true")
+		}
+	})
+}
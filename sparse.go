@@ -0,0 +1,499 @@
+package matrix
+
+import "sort"
+
+/*
+Implemented by data stores that only hold the non zero elements of a matrix. Operations
+check for this interface to iterate the stored elements instead of reading every cell.
+*/
+type SparseReader[T Element] interface {
+	DataReader[T]
+	NonZeros() uint
+	ForEachNonZero(fn func(i, j uint, value T))
+	ForEachNonZeroInRow(i uint, fn func(j uint, value T))
+}
+
+/*
+A compressed sparse row data store. The column indices and values of row i are held in
+columnIndex[rowPointer[i]:rowPointer[i+1]] and values[rowPointer[i]:rowPointer[i+1]]
+with the column indices in ascending order. Only non zero values are stored.
+*/
+type CSRStore[T Element] struct {
+	rows        uint
+	columns     uint
+	rowPointer  []uint
+	columnIndex []uint
+	values      []T
+}
+
+/*
+Create a CSR store from its raw arrays. The arrays are used directly, not copied, and
+must describe a valid structure holding no zero values.
+*/
+func NewCSRStore[T Element](rows, columns uint, rowPointer, columnIndex []uint, values []T) (*CSRStore[T], error) {
+	store := &CSRStore[T]{
+		rows:        rows,
+		columns:     columns,
+		rowPointer:  rowPointer,
+		columnIndex: columnIndex,
+		values:      values,
+	}
+
+	err := store.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+// Create an empty CSR store where every element is zero
+func newEmptyCSRStore[T Element](rows, columns uint) *CSRStore[T] {
+	return &CSRStore[T]{
+		rows:       rows,
+		columns:    columns,
+		rowPointer: make([]uint, rows+1),
+	}
+}
+
+// Read a value from a position in the matrix
+func (s *CSRStore[T]) Read(i, j uint) T {
+	h, ok := s.find(i, j)
+	if !ok {
+		var zero T
+		return zero
+	}
+	return s.values[h]
+}
+
+// Get the dimensions of the underlying matrix data store
+func (s *CSRStore[T]) Shape() (uint, uint) {
+	return s.rows, s.columns
+}
+
+/*
+Ensure that the row pointers and column indices describe a valid structure. Stored zeros
+are rejected since sparse searches and counts only visit stored elements.
+*/
+func (s *CSRStore[T]) Validate() error {
+	if s.rows == 0 || s.columns == 0 {
+		return ErrRowColumSize
+	}
+
+	if uint(len(s.rowPointer)) != s.rows+1 || s.rowPointer[0] != 0 {
+		return ErrSparseStructure
+	}
+
+	nnz := s.rowPointer[s.rows]
+	if uint(len(s.columnIndex)) != nnz || uint(len(s.values)) != nnz {
+		return ErrSparseStructure
+	}
+
+	for i := uint(0); i < s.rows; i++ {
+		start, end := s.rowPointer[i], s.rowPointer[i+1]
+		if start > end {
+			return ErrSparseStructure
+		}
+
+		for h := start; h < end; h++ {
+			if s.columnIndex[h] >= s.columns {
+				return ErrSparseStructure
+			}
+			if h > start && s.columnIndex[h] <= s.columnIndex[h-1] {
+				return ErrSparseStructure
+			}
+			if s.values[h] == 0 {
+				return ErrSparseStructure
+			}
+		}
+	}
+
+	return nil
+}
+
+/*
+Write a value to a position in the matrix. Writing a non zero value to an empty position
+inserts a new entry and writing zero removes the entry, both are linear in the number of
+stored elements.
+*/
+func (s *CSRStore[T]) Write(i, j uint, value T) {
+	h, ok := s.find(i, j)
+
+	if ok {
+		if value != 0 {
+			s.values[h] = value
+			return
+		}
+
+		s.columnIndex = append(s.columnIndex[:h], s.columnIndex[h+1:]...)
+		s.values = append(s.values[:h], s.values[h+1:]...)
+		for r := i + 1; r <= s.rows; r++ {
+			s.rowPointer[r]--
+		}
+		return
+	}
+
+	if value == 0 {
+		return
+	}
+
+	s.columnIndex = append(s.columnIndex, 0)
+	copy(s.columnIndex[h+1:], s.columnIndex[h:])
+	s.columnIndex[h] = j
+
+	s.values = append(s.values, value)
+	copy(s.values[h+1:], s.values[h:])
+	s.values[h] = value
+
+	for r := i + 1; r <= s.rows; r++ {
+		s.rowPointer[r]++
+	}
+}
+
+// The number of stored non zero elements
+func (s *CSRStore[T]) NonZeros() uint {
+	return uint(len(s.values))
+}
+
+// Call fn for every stored element in row major order
+func (s *CSRStore[T]) ForEachNonZero(fn func(i, j uint, value T)) {
+	for i := uint(0); i < s.rows; i++ {
+		for h := s.rowPointer[i]; h < s.rowPointer[i+1]; h++ {
+			fn(i, s.columnIndex[h], s.values[h])
+		}
+	}
+}
+
+// Call fn for every stored element in row i in column order
+func (s *CSRStore[T]) ForEachNonZeroInRow(i uint, fn func(j uint, value T)) {
+	for h := s.rowPointer[i]; h < s.rowPointer[i+1]; h++ {
+		fn(s.columnIndex[h], s.values[h])
+	}
+}
+
+/*
+Find the offset of position i, j in the column index and values. If the position is not
+stored the offset is where it would be inserted.
+*/
+func (s *CSRStore[T]) find(i, j uint) (uint, bool) {
+	start, end := s.rowPointer[i], s.rowPointer[i+1]
+	row := s.columnIndex[start:end]
+
+	h := uint(sort.Search(len(row), func(k int) bool {
+		return row[k] >= j
+	}))
+
+	return start + h, h < uint(len(row)) && row[h] == j
+}
+
+/*
+Append the elements of row i when building a store row by row. Rows must be appended in
+order and the column indices must be ascending.
+*/
+func (s *CSRStore[T]) appendRow(i uint, columns []uint, values []T) {
+	for h, j := range columns {
+		if values[h] == 0 {
+			continue
+		}
+		s.columnIndex = append(s.columnIndex, j)
+		s.values = append(s.values, values[h])
+	}
+	s.rowPointer[i+1] = uint(len(s.values))
+}
+
+type cooEntry[T Element] struct {
+	i, j  uint
+	value T
+}
+
+/*
+Collects elements in coordinate (COO) form in any order, then converts them to a CSR
+store. Elements added to the same position more than once are summed.
+*/
+type COOBuilder[T Element] struct {
+	rows    uint
+	columns uint
+	entries []cooEntry[T]
+}
+
+func NewCOOBuilder[T Element](rows, columns uint) (*COOBuilder[T], error) {
+	if rows == 0 || columns == 0 {
+		return nil, ErrRowColumSize
+	}
+
+	return &COOBuilder[T]{rows: rows, columns: columns}, nil
+}
+
+// Add an element at row i and column j, 0 indexed
+func (b *COOBuilder[T]) Add(i, j uint, value T) error {
+	if i >= b.rows || j >= b.columns {
+		return ErrMatrixOutOfBounds
+	}
+
+	b.entries = append(b.entries, cooEntry[T]{i: i, j: j, value: value})
+	return nil
+}
+
+/*
+Convert the collected elements to a CSR store. Duplicates are summed and any element
+that is zero afterwards is dropped.
+*/
+func (b *COOBuilder[T]) CSR() *CSRStore[T] {
+	entries := make([]cooEntry[T], len(b.entries))
+	copy(entries, b.entries)
+
+	sort.SliceStable(entries, func(x, y int) bool {
+		return positionLess([2]uint{entries[x].i, entries[x].j}, [2]uint{entries[y].i, entries[y].j})
+	})
+
+	store := &CSRStore[T]{
+		rows:       b.rows,
+		columns:    b.columns,
+		rowPointer: make([]uint, b.rows+1),
+	}
+
+	for h := 0; h < len(entries); {
+		e := entries[h]
+		sum := e.value
+		h++
+		for h < len(entries) && entries[h].i == e.i && entries[h].j == e.j {
+			sum += entries[h].value
+			h++
+		}
+
+		if sum == 0 {
+			continue
+		}
+
+		store.columnIndex = append(store.columnIndex, e.j)
+		store.values = append(store.values, sum)
+		store.rowPointer[e.i+1]++
+	}
+
+	for i := uint(1); i <= b.rows; i++ {
+		store.rowPointer[i] += store.rowPointer[i-1]
+	}
+
+	return store
+}
+
+// Convert the collected elements to a CSR backed matrix
+func (b *COOBuilder[T]) Matrix() (*Matrix[T], error) {
+	return NewMatrix[T](b.CSR())
+}
+
+// Return the sparse reader for the matrix if the data store supports sparse iteration
+func sparseReader[T Element](m *Matrix[T]) (SparseReader[T], bool) {
+	s, ok := m.reader.(SparseReader[T])
+	return s, ok
+}
+
+func isSparse[T Element](m *Matrix[T]) bool {
+	_, ok := sparseReader(m)
+	return ok
+}
+
+/*
+Call fn for every non zero element in row i. Sparse stores iterate only their stored
+elements, other stores read every column and skip zeros.
+*/
+func forEachNonZeroInRow[T Element](m *Matrix[T], i uint, fn func(j uint, value T)) {
+	if s, ok := sparseReader(m); ok {
+		s.ForEachNonZeroInRow(i, fn)
+		return
+	}
+
+	for j := uint(0); j < m.columns; j++ {
+		v := m.reader.Read(i, j)
+		if v != 0 {
+			fn(j, v)
+		}
+	}
+}
+
+/*
+Multiply where at least one operand is sparse. Each output row is accumulated from the non
+zeros of row i of m scaled by the non zeros of the matching rows of a. The result is sparse
+when both operands are sparse.
+*/
+func sparseMultiply[T Element](m, a *Matrix[T]) (*Matrix[T], error) {
+	_, mSparse := sparseReader(m)
+	_, aSparse := sparseReader(a)
+
+	accumulator := make([]T, a.columns)
+	touched := make([]bool, a.columns)
+	var columns []uint
+
+	var result *Matrix[T]
+	var store *CSRStore[T]
+	var err error
+
+	if mSparse && aSparse {
+		store = newEmptyCSRStore[T](m.rows, a.columns)
+		result, err = NewMatrix[T](store)
+	} else {
+		result, err = NewEmptyMatrix[T](m.rows, a.columns)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for i := uint(0); i < m.rows; i++ {
+		columns = columns[:0]
+
+		forEachNonZeroInRow(m, i, func(k uint, x T) {
+			forEachNonZeroInRow(a, k, func(j uint, y T) {
+				if !touched[j] {
+					touched[j] = true
+					columns = append(columns, j)
+				}
+				accumulator[j] += x * y
+			})
+		})
+
+		sort.Slice(columns, func(x, y int) bool { return columns[x] < columns[y] })
+
+		values := make([]T, len(columns))
+		for h, j := range columns {
+			values[h] = accumulator[j]
+			accumulator[j] = 0
+			touched[j] = false
+		}
+
+		if store != nil {
+			store.appendRow(i, columns, values)
+			continue
+		}
+
+		for h, j := range columns {
+			result.writer.Write(i, j, values[h])
+		}
+	}
+
+	return result, nil
+}
+
+/*
+Add where at least one operand is sparse. With two sparse operands the rows are merged
+into a sparse result, otherwise the dense operand is copied and the non zeros of the
+sparse operand are added to it.
+*/
+func sparseAdd[T Element](m, a *Matrix[T]) (*Matrix[T], error) {
+	ms, mSparse := sparseReader(m)
+	as, aSparse := sparseReader(a)
+
+	if mSparse && aSparse {
+		store := newEmptyCSRStore[T](m.rows, m.columns)
+		accumulator := make([]T, m.columns)
+		touched := make([]bool, m.columns)
+		var columns []uint
+
+		collect := func(j uint, v T) {
+			if !touched[j] {
+				touched[j] = true
+				columns = append(columns, j)
+			}
+			accumulator[j] += v
+		}
+
+		for i := uint(0); i < m.rows; i++ {
+			columns = columns[:0]
+			ms.ForEachNonZeroInRow(i, collect)
+			as.ForEachNonZeroInRow(i, collect)
+
+			sort.Slice(columns, func(x, y int) bool { return columns[x] < columns[y] })
+
+			values := make([]T, len(columns))
+			for h, j := range columns {
+				values[h] = accumulator[j]
+				accumulator[j] = 0
+				touched[j] = false
+			}
+			store.appendRow(i, columns, values)
+		}
+
+		return NewMatrix[T](store)
+	}
+
+	dense, sparse := m, as
+	if mSparse {
+		dense, sparse = a, ms
+	}
+
+	result, err := dense.Clone()
+	if err != nil {
+		return nil, err
+	}
+
+	sparse.ForEachNonZero(func(i, j uint, v T) {
+		result.writer.Write(i, j, result.reader.Read(i, j)+v)
+	})
+
+	return result, nil
+}
+
+// Transpose a sparse matrix into a new sparse matrix
+func sparseTranspose[T Element](s SparseReader[T], rows, columns uint) (*Matrix[T], error) {
+	store := &CSRStore[T]{
+		rows:        columns,
+		columns:     rows,
+		rowPointer:  make([]uint, columns+1),
+		columnIndex: make([]uint, s.NonZeros()),
+		values:      make([]T, s.NonZeros()),
+	}
+
+	// Count the elements in each column, which become the rows of the result
+	s.ForEachNonZero(func(i, j uint, v T) {
+		store.rowPointer[j+1]++
+	})
+	for j := uint(1); j <= columns; j++ {
+		store.rowPointer[j] += store.rowPointer[j-1]
+	}
+
+	next := make([]uint, columns)
+	copy(next, store.rowPointer[:columns])
+
+	// Row major iteration places the row indices of each column in ascending order
+	s.ForEachNonZero(func(i, j uint, v T) {
+		h := next[j]
+		store.columnIndex[h] = i
+		store.values[h] = v
+		next[j]++
+	})
+
+	return NewMatrix[T](store)
+}
+
+/*
+Search a sparse matrix for an element. Non zero elements are found from the stored
+elements alone, zero is found from the gaps between them.
+*/
+func sparseSearch[T Element](s SparseReader[T], rows, columns uint, element T) []Location[T] {
+	var found []Location[T]
+
+	if element != 0 {
+		s.ForEachNonZero(func(i, j uint, v T) {
+			if v == element {
+				found = append(found, Location[T]{position: [2]uint{i, j}, value: v})
+			}
+		})
+		return found
+	}
+
+	for i := uint(0); i < rows; i++ {
+		next := uint(0)
+		emit := func(end uint) {
+			for ; next < end; next++ {
+				found = append(found, Location[T]{position: [2]uint{i, next}, value: element})
+			}
+		}
+
+		s.ForEachNonZeroInRow(i, func(j uint, v T) {
+			emit(j)
+			next = j + 1
+		})
+		emit(columns)
+	}
+
+	return found
+}
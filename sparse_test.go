@@ -0,0 +1,261 @@
+package matrix
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// Wraps a CSR store counting calls to Read so tests can tell if every cell was visited
+type CountingCSRStore[T Element] struct {
+	*CSRStore[T]
+	reads int
+}
+
+func (c *CountingCSRStore[T]) Read(i, j uint) T {
+	c.reads++
+	return c.CSRStore.Read(i, j)
+}
+
+func newSparseFromSlice[T Element](t *testing.T, data [][]T) *Matrix[T] {
+	t.Helper()
+
+	b, _ := NewCOOBuilder[T](uint(len(data)), uint(len(data[0])))
+	for i, row := range data {
+		for j, v := range row {
+			b.Add(uint(i), uint(j), v)
+		}
+	}
+
+	m, err := b.Matrix()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func TestNewCSRStore(t *testing.T) {
+	t.Run("it creates a store from raw arrays", func(t *testing.T) {
+		store, err := NewCSRStore[int](2, 3, []uint{0, 1, 3}, []uint{2, 0, 1}, []int{5, 6, 7})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		m, _ := NewMatrix[int](store)
+		want, _ := NewMatrixFromSlice([][]int{
+			{0, 0, 5},
+			{6, 7, 0},
+		})
+
+		elementsAreEqual(t, m, want)
+	})
+
+	t.Run("it returns an error for an invalid structure", func(t *testing.T) {
+		cases := []struct {
+			name        string
+			rowPointer  []uint
+			columnIndex []uint
+			values      []int
+		}{
+			{name: "row pointer length", rowPointer: []uint{0, 1}, columnIndex: []uint{0}, values: []int{1}},
+			{name: "column out of range", rowPointer: []uint{0, 1, 1}, columnIndex: []uint{3}, values: []int{1}},
+			{name: "columns not ascending", rowPointer: []uint{0, 2, 2}, columnIndex: []uint{1, 0}, values: []int{1, 2}},
+			{name: "values length", rowPointer: []uint{0, 1, 1}, columnIndex: []uint{0}, values: []int{}},
+			{name: "stored zero", rowPointer: []uint{0, 1, 1}, columnIndex: []uint{0}, values: []int{0}},
+		}
+
+		for _, test := range cases {
+			t.Run(test.name, func(t *testing.T) {
+				_, err := NewCSRStore(2, 3, test.rowPointer, test.columnIndex, test.values)
+				if !errors.Is(err, ErrSparseStructure) {
+					t.Errorf("expected ErrSparseStructure, got %v", err)
+				}
+			})
+		}
+	})
+}
+
+func TestCSRStoreWrite(t *testing.T) {
+	t.Run("it inserts, updates and removes elements", func(t *testing.T) {
+		m := newSparseFromSlice(t, [][]int{
+			{1, 0, 0},
+			{0, 0, 2},
+		})
+
+		m.Set(0, 2, 3)
+		m.Set(1, 2, 4)
+		m.Set(0, 0, 0)
+		m.Set(1, 0, 0)
+
+		want, _ := NewMatrixFromSlice([][]int{
+			{0, 0, 3},
+			{0, 0, 4},
+		})
+		elementsAreEqual(t, m, want)
+
+		store := m.reader.(*CSRStore[int])
+		if store.NonZeros() != 2 {
+			t.Errorf("expected 2 stored elements, got %d", store.NonZeros())
+		}
+
+		if err := store.Validate(); err != nil {
+			t.Errorf("expected a valid structure, got %v", err)
+		}
+	})
+}
+
+func TestCOOBuilder(t *testing.T) {
+	t.Run("it sums duplicates and drops zeros", func(t *testing.T) {
+		b, _ := NewCOOBuilder[int](2, 2)
+		b.Add(1, 1, 4)
+		b.Add(0, 1, 2)
+		b.Add(1, 1, 3)
+		b.Add(1, 0, 5)
+		b.Add(1, 0, -5)
+
+		store := b.CSR()
+
+		want := &CSRStore[int]{
+			rows:        2,
+			columns:     2,
+			rowPointer:  []uint{0, 1, 2},
+			columnIndex: []uint{1, 1},
+			values:      []int{2, 7},
+		}
+
+		if !reflect.DeepEqual(want, store) {
+			t.Errorf("want %+v, got %+v", want, store)
+		}
+	})
+
+	t.Run("it returns an error for an out of bounds position", func(t *testing.T) {
+		b, _ := NewCOOBuilder[int](2, 2)
+
+		err := b.Add(2, 0, 1)
+		if !errors.Is(err, ErrMatrixOutOfBounds) {
+			t.Errorf("expected ErrMatrixOutOfBounds, got %v", err)
+		}
+	})
+}
+
+func TestSparseOperations(t *testing.T) {
+	a := [][]int{
+		{0, 2, 0, 0},
+		{0, 0, 0, 0},
+		{1, 0, 0, 3},
+	}
+	b := [][]int{
+		{0, 0, 4},
+		{5, 0, 0},
+		{0, 0, 0},
+		{0, 6, 0},
+	}
+
+	t.Run("it multiplies sparse and dense combinations", func(t *testing.T) {
+		denseA, _ := NewMatrixFromSlice(a)
+		denseB, _ := NewMatrixFromSlice(b)
+		want, _ := denseA.Multiply(denseB)
+
+		cases := []struct {
+			name string
+			a, b *Matrix[int]
+		}{
+			{name: "sparse x sparse", a: newSparseFromSlice(t, a), b: newSparseFromSlice(t, b)},
+			{name: "sparse x dense", a: newSparseFromSlice(t, a), b: denseB},
+			{name: "dense x sparse", a: denseA, b: newSparseFromSlice(t, b)},
+		}
+
+		for _, test := range cases {
+			t.Run(test.name, func(t *testing.T) {
+				got, err := test.a.Multiply(test.b)
+				if err != nil {
+					t.Fatal(err)
+				}
+				elementsAreEqual(t, got, want)
+			})
+		}
+	})
+
+	t.Run("it keeps the result sparse when both operands are sparse", func(t *testing.T) {
+		got, _ := newSparseFromSlice(t, a).Multiply(newSparseFromSlice(t, b))
+
+		if !isSparse(got) {
+			t.Error("expected a sparse result")
+		}
+	})
+
+	t.Run("it adds sparse and dense combinations", func(t *testing.T) {
+		c := [][]int{
+			{0, -2, 0, 1},
+			{0, 0, 0, 0},
+			{0, 0, 7, 0},
+		}
+		denseA, _ := NewMatrixFromSlice(a)
+		denseC, _ := NewMatrixFromSlice(c)
+		want, _ := denseA.Add(denseC)
+
+		sparseSum, _ := newSparseFromSlice(t, a).Add(newSparseFromSlice(t, c))
+		elementsAreEqual(t, sparseSum, want)
+
+		if sparseSum.reader.(*CSRStore[int]).NonZeros() != 4 {
+			t.Error("expected cancelled elements to be dropped from the result")
+		}
+
+		mixed, _ := newSparseFromSlice(t, a).Add(denseC)
+		elementsAreEqual(t, mixed, want)
+
+		mixed, _ = denseA.Add(newSparseFromSlice(t, c))
+		elementsAreEqual(t, mixed, want)
+	})
+
+	t.Run("it transposes a sparse matrix", func(t *testing.T) {
+		dense, _ := NewMatrixFromSlice(a)
+		want, _ := dense.Transpose()
+
+		got, _ := newSparseFromSlice(t, a).Transpose()
+		elementsAreEqual(t, got, want)
+
+		if err := got.reader.Validate(); err != nil {
+			t.Errorf("expected a valid structure, got %v", err)
+		}
+	})
+
+	t.Run("it flattens a sparse matrix", func(t *testing.T) {
+		dense, _ := NewMatrixFromSlice(a)
+
+		got := newSparseFromSlice(t, a).Flatten()
+		if !reflect.DeepEqual(got, dense.Flatten()) {
+			t.Errorf("want %v, got %v", dense.Flatten(), got)
+		}
+	})
+
+	t.Run("it searches for zero and non zero elements", func(t *testing.T) {
+		dense, _ := NewMatrixFromSlice(a)
+		sparse := newSparseFromSlice(t, a)
+
+		for _, v := range []int{0, 1, 3, 9} {
+			got, gotFound := sparse.Search(v)
+			want, wantFound := dense.Search(v)
+
+			if gotFound != wantFound || !reflect.DeepEqual(got, want) {
+				t.Errorf("search for %d: want %v, got %v", v, want, got)
+			}
+		}
+	})
+
+	t.Run("it does not read every cell", func(t *testing.T) {
+		sparse := newSparseFromSlice(t, a)
+		counting := &CountingCSRStore[int]{CSRStore: sparse.reader.(*CSRStore[int])}
+		m, _ := NewMatrix[int](counting)
+
+		transposed, _ := m.Transpose()
+		m.Multiply(transposed)
+		m.Add(m)
+		m.Search(3)
+		m.Flatten()
+
+		if counting.reads != 0 {
+			t.Errorf("expected no reads, got %d", counting.reads)
+		}
+	})
+}
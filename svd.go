@@ -0,0 +1,293 @@
+package matrix
+
+import (
+	"math"
+	"sort"
+)
+
+// The most one-sided Jacobi sweeps before giving up on the SVD
+const maxSVDSweeps = 100
+
+/*
+The thin singular value decomposition of a matrix, A = USV^T where S is diagonal with the
+singular values in decreasing order and U and V have orthonormal columns, one per
+singular value.
+*/
+type SVD[T Float] struct {
+	rows    uint
+	columns uint
+	u       [][]T
+	values  []T
+	v       [][]T
+}
+
+/*
+Factor a matrix of any shape using one-sided Jacobi rotations, which computes small
+singular values to high relative accuracy. Columns of U that belong to a zero singular
+value are left as zero.
+*/
+func NewSVD[T Float](m *Matrix[T]) (*SVD[T], error) {
+	rows, columns := m.rows, m.columns
+
+	// Orthogonalize the columns of whichever of A and A^T is tall
+	transposed := rows < columns
+	tall, wide := rows, columns
+	if transposed {
+		tall, wide = columns, rows
+	}
+
+	u := make([][]T, tall)
+	for i := uint(0); i < tall; i++ {
+		u[i] = make([]T, wide)
+		for j := uint(0); j < wide; j++ {
+			if transposed {
+				u[i][j] = m.reader.Read(j, i)
+			} else {
+				u[i][j] = m.reader.Read(i, j)
+			}
+		}
+	}
+
+	v := make([][]T, wide)
+	for i := uint(0); i < wide; i++ {
+		v[i] = make([]T, wide)
+		v[i][i] = 1
+	}
+
+	eps := machineEpsilon[T]()
+	converged := false
+
+	for sweep := 0; sweep < maxSVDSweeps; sweep++ {
+		rotated := false
+
+		for p := uint(0); p < wide; p++ {
+			for q := p + 1; q < wide; q++ {
+				var alpha, beta, gamma T
+				for i := uint(0); i < tall; i++ {
+					alpha += u[i][p] * u[i][p]
+					beta += u[i][q] * u[i][q]
+					gamma += u[i][p] * u[i][q]
+				}
+
+				// Skip columns that are already orthogonal to working precision
+				if abs(gamma) <= eps*T(math.Sqrt(float64(alpha*beta))) {
+					continue
+				}
+				rotated = true
+
+				zeta := (beta - alpha) / (2 * gamma)
+				t := 1 / (abs(zeta) + T(math.Sqrt(float64(1+zeta*zeta))))
+				if zeta < 0 {
+					t = -t
+				}
+				c := 1 / T(math.Sqrt(float64(1+t*t)))
+				s := c * t
+
+				for i := uint(0); i < tall; i++ {
+					up, uq := u[i][p], u[i][q]
+					u[i][p] = c*up - s*uq
+					u[i][q] = s*up + c*uq
+				}
+
+				for i := uint(0); i < wide; i++ {
+					vp, vq := v[i][p], v[i][q]
+					v[i][p] = c*vp - s*vq
+					v[i][q] = s*vp + c*vq
+				}
+			}
+		}
+
+		if !rotated {
+			converged = true
+			break
+		}
+	}
+
+	if !converged {
+		return nil, ErrNoConvergence
+	}
+
+	// The singular values are the norms of the orthogonalized columns
+	values := make([]T, wide)
+	for j := uint(0); j < wide; j++ {
+		values[j] = columnNorm(u, 0, j)
+		if values[j] != 0 {
+			for i := uint(0); i < tall; i++ {
+				u[i][j] /= values[j]
+			}
+		}
+	}
+
+	order := make([]uint, wide)
+	for j := range order {
+		order[j] = uint(j)
+	}
+	sort.SliceStable(order, func(x, y int) bool {
+		return values[order[x]] > values[order[y]]
+	})
+
+	f := &SVD[T]{
+		rows:    rows,
+		columns: columns,
+		u:       sortColumns(u, order),
+		values:  make([]T, wide),
+		v:       sortColumns(v, order),
+	}
+
+	for j, k := range order {
+		f.values[j] = values[k]
+	}
+
+	// A^T = USV^T gives A = VSU^T, so the factors swap
+	if transposed {
+		f.u, f.v = f.v, f.u
+	}
+
+	return f, nil
+}
+
+// Return a copy of a with its columns rearranged so that column j is column order[j] of a
+func sortColumns[T Float](a [][]T, order []uint) [][]T {
+	sorted := make([][]T, len(a))
+	for i := range a {
+		sorted[i] = make([]T, len(order))
+		for j, k := range order {
+			sorted[i][j] = a[i][k]
+		}
+	}
+	return sorted
+}
+
+// Return the singular values in decreasing order
+func (f *SVD[T]) Values() []T {
+	values := make([]T, len(f.values))
+	copy(values, f.values)
+	return values
+}
+
+// Return the left singular vectors, one column per singular value and one row per matrix row
+func (f *SVD[T]) U() *Matrix[T] {
+	return f.factor(f.u, f.rows)
+}
+
+// Return the right singular vectors, one column per singular value and one row per matrix column
+func (f *SVD[T]) V() *Matrix[T] {
+	return f.factor(f.v, f.columns)
+}
+
+func (f *SVD[T]) factor(a [][]T, rows uint) *Matrix[T] {
+	k := uint(len(f.values))
+	m, _ := NewEmptyMatrix[T](rows, k)
+
+	for i := uint(0); i < rows; i++ {
+		for j := uint(0); j < k; j++ {
+			m.writer.Write(i, j, a[i][j])
+		}
+	}
+
+	return m
+}
+
+/*
+Return the number of singular values larger than tol. A tol of zero uses the default of
+max(rows, columns) * epsilon * the largest singular value.
+*/
+func (f *SVD[T]) Rank(tol T) uint {
+	if tol <= 0 {
+		tol = f.tolerance()
+	}
+
+	var rank uint
+	for _, s := range f.values {
+		if s > tol {
+			rank++
+		}
+	}
+	return rank
+}
+
+func (f *SVD[T]) tolerance() T {
+	return T(max(f.rows, f.columns)) * machineEpsilon[T]() * f.values[0]
+}
+
+/*
+Return the ratio of the largest to the smallest singular value. Large values mean that
+small changes in the input can cause large changes in results computed from the matrix,
+a singular matrix returns +Inf.
+*/
+func (f *SVD[T]) ConditionNumber() T {
+	smallest := f.values[len(f.values)-1]
+	if smallest == 0 {
+		return T(math.Inf(1))
+	}
+	return f.values[0] / smallest
+}
+
+/*
+Return the Moore-Penrose pseudo-inverse, a columns by rows matrix. Singular values at or
+below the default tolerance of Rank are treated as zero, so the result is well defined
+for singular and non-square matrixes. Equal to the inverse for an invertible matrix.
+*/
+func (f *SVD[T]) PseudoInverse() *Matrix[T] {
+	p, _ := NewEmptyMatrix[T](f.columns, f.rows)
+	rank := f.Rank(0)
+
+	for i := uint(0); i < f.columns; i++ {
+		for j := uint(0); j < f.rows; j++ {
+			var sum T
+			for k := uint(0); k < rank; k++ {
+				sum += f.v[i][k] * f.u[j][k] / f.values[k]
+			}
+			p.writer.Write(i, j, sum)
+		}
+	}
+
+	return p
+}
+
+/*
+Return the best approximation to the matrix with rank at most k, keeping the k largest
+singular values. The result is a full rows x columns dense matrix, use Truncate to keep
+the approximation in factored form. A k of at least min(rows, columns) reconstructs the
+whole matrix.
+*/
+func (f *SVD[T]) Approximate(k uint) *Matrix[T] {
+	k = min(k, uint(len(f.values)))
+	a, _ := NewEmptyMatrix[T](f.rows, f.columns)
+
+	for i := uint(0); i < f.rows; i++ {
+		for j := uint(0); j < f.columns; j++ {
+			var sum T
+			for h := uint(0); h < k; h++ {
+				sum += f.u[i][h] * f.values[h] * f.v[j][h]
+			}
+			a.writer.Write(i, j, sum)
+		}
+	}
+
+	return a
+}
+
+/*
+Return the decomposition truncated to the k largest singular values, so U is rows x k,
+V is columns x k and the factors take k * (rows + columns + 1) elements rather than
+rows * columns. k is clamped between 1 and the number of singular values. The receiver
+is not modified.
+*/
+func (f *SVD[T]) Truncate(k uint) *SVD[T] {
+	k = max(1, min(k, uint(len(f.values))))
+
+	keep := func(a [][]T) [][]T {
+		b := make([][]T, len(a))
+		for i := range a {
+			b[i] = make([]T, k)
+			copy(b[i], a[i][:k])
+		}
+		return b
+	}
+
+	values := make([]T, k)
+	copy(values, f.values[:k])
+
+	return &SVD[T]{rows: f.rows, columns: f.columns, u: keep(f.u), values: values, v: keep(f.v)}
+}
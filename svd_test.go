@@ -0,0 +1,210 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNewSVD(t *testing.T) {
+	reconstruct := func(f *SVD[float64]) *Matrix[float64] {
+		values := f.Values()
+		s, _ := NewEmptyMatrix[float64](uint(len(values)), uint(len(values)))
+		for i, value := range values {
+			s.writer.Write(uint(i), uint(i), value)
+		}
+
+		us, _ := f.U().Multiply(s)
+		vt, _ := f.V().Transpose()
+		a, _ := us.Multiply(vt)
+		return a
+	}
+
+	assertOrthonormalColumns := func(t *testing.T, m *Matrix[float64]) {
+		t.Helper()
+
+		mt, _ := m.Transpose()
+		product, _ := mt.Multiply(m)
+		identity, _ := NewIdentityMatrix[float64](m.Columns())
+		matrixesAreClose(t, product, identity, 1e-12)
+	}
+
+	cases := []struct {
+		name  string
+		input [][]float64
+	}{
+		{name: "tall", input: [][]float64{{1, 2}, {3, 4}, {5, 6}, {7, 8}}},
+		{name: "wide", input: [][]float64{{2, 0, 1, -1}, {1, 3, 0, 2}}},
+		{name: "square", input: [][]float64{{4, 0, 1}, {2, -3, 2}, {1, 1, 5}}},
+	}
+
+	for _, test := range cases {
+		t.Run("it factors a "+test.name+" matrix so that A = USV^T", func(t *testing.T) {
+			a, _ := NewMatrixFromSlice(test.input)
+
+			f, err := NewSVD(a)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			matrixesAreClose(t, reconstruct(f), a, 1e-12)
+			assertOrthonormalColumns(t, f.U())
+			assertOrthonormalColumns(t, f.V())
+
+			values := f.Values()
+			for i := 1; i < len(values); i++ {
+				if values[i-1] < values[i] {
+					t.Errorf("expected decreasing singular values, got %v", values)
+				}
+			}
+		})
+	}
+
+	t.Run("it finds known singular values", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice([][]float64{
+			{3, 2, 2},
+			{2, 3, -2},
+		})
+
+		f, _ := NewSVD(a)
+		values := f.Values()
+		if math.Abs(values[0]-5) > 1e-12 || math.Abs(values[1]-3) > 1e-12 {
+			t.Errorf("expected singular values [5 3], got %v", values)
+		}
+	})
+}
+
+func TestSVDRank(t *testing.T) {
+	t.Run("it counts the singular values above the tolerance", func(t *testing.T) {
+		// The third row is the sum of the first two
+		a, _ := NewMatrixFromSlice([][]float64{
+			{1, 2, 3},
+			{4, 5, 6},
+			{5, 7, 9},
+		})
+
+		f, _ := NewSVD(a)
+		if f.Rank(0) != 2 {
+			t.Errorf("expected rank 2, got %d", f.Rank(0))
+		}
+		if f.Rank(1e6) != 0 {
+			t.Errorf("expected rank 0 with a large tolerance, got %d", f.Rank(1e6))
+		}
+	})
+
+	t.Run("it returns zero for the zero matrix", func(t *testing.T) {
+		a, _ := NewEmptyMatrix[float64](3, 2)
+
+		f, _ := NewSVD(a)
+		if f.Rank(0) != 0 {
+			t.Errorf("expected rank 0, got %d", f.Rank(0))
+		}
+	})
+}
+
+func TestSVDConditionNumber(t *testing.T) {
+	t.Run("it returns the ratio of the extreme singular values", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice([][]float64{{10, 0}, {0, 0.5}})
+
+		f, _ := NewSVD(a)
+		if math.Abs(f.ConditionNumber()-20) > 1e-12 {
+			t.Errorf("expected 20, got %v", f.ConditionNumber())
+		}
+	})
+
+	t.Run("it returns a huge or infinite value for a singular matrix", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice([][]float64{{1, 2}, {2, 4}})
+
+		f, _ := NewSVD(a)
+		if f.ConditionNumber() < 1e15 {
+			t.Errorf("expected a very large condition number, got %v", f.ConditionNumber())
+		}
+
+		z, _ := NewEmptyMatrix[float64](2, 2)
+		f, _ = NewSVD(z)
+		if !math.IsInf(f.ConditionNumber(), 1) {
+			t.Errorf("expected +Inf, got %v", f.ConditionNumber())
+		}
+	})
+}
+
+func TestSVDPseudoInverse(t *testing.T) {
+	t.Run("it matches the inverse of an invertible matrix", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice([][]float64{{4, 7}, {2, 6}})
+
+		f, _ := NewSVD(a)
+		want, _ := NewMatrixFromSlice([][]float64{{0.6, -0.7}, {-0.2, 0.4}})
+		matrixesAreClose(t, f.PseudoInverse(), want, 1e-12)
+	})
+
+	t.Run("it satisfies the Moore-Penrose conditions for a rank deficient matrix", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice([][]float64{
+			{1, 2},
+			{2, 4},
+			{3, 6},
+		})
+
+		f, _ := NewSVD(a)
+		p := f.PseudoInverse()
+		if p.Rows() != 2 || p.Columns() != 3 {
+			t.Fatalf("expected a 2x3 pseudo-inverse, got %dx%d", p.Rows(), p.Columns())
+		}
+
+		// A A+ A = A and A+ A A+ = A+
+		ap, _ := a.Multiply(p)
+		apa, _ := ap.Multiply(a)
+		matrixesAreClose(t, apa, a, 1e-12)
+
+		pa, _ := p.Multiply(a)
+		pap, _ := pa.Multiply(p)
+		matrixesAreClose(t, pap, p, 1e-12)
+	})
+}
+
+func TestSVDApproximate(t *testing.T) {
+	input := [][]float32{
+		{3, 2, 2},
+		{2, 3, -2},
+	}
+
+	t.Run("it keeps the largest singular values", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice(input)
+		f, _ := NewSVD(a)
+
+		// The rank one approximation is 5 u1 v1^T with u1 = [1 1]/sqrt(2), v1 = [1 1 0]/sqrt(2)
+		want, _ := NewMatrixFromSlice([][]float32{
+			{2.5, 2.5, 0},
+			{2.5, 2.5, 0},
+		})
+		matrixesAreClose(t, f.Approximate(1), want, 1e-5)
+	})
+
+	t.Run("it reconstructs the matrix when k covers every singular value", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice(input)
+		f, _ := NewSVD(a)
+
+		matrixesAreClose(t, f.Approximate(5), a, 1e-5)
+	})
+
+	t.Run("it truncates the factors to k columns", func(t *testing.T) {
+		a, _ := NewMatrixFromSlice(input)
+		f, _ := NewSVD(a)
+
+		g := f.Truncate(1)
+
+		if u := g.U(); u.rows != 2 || u.columns != 1 {
+			t.Errorf("expected U to be 2x1, got %dx%d", u.rows, u.columns)
+		}
+		if v := g.V(); v.rows != 3 || v.columns != 1 {
+			t.Errorf("expected V to be 3x1, got %dx%d", v.rows, v.columns)
+		}
+		if values := g.Values(); len(values) != 1 || math.Abs(float64(values[0])-5) > 1e-5 {
+			t.Errorf("expected the values [5], got %v", values)
+		}
+
+		matrixesAreClose(t, g.Approximate(5), f.Approximate(1), 1e-5)
+
+		if len(f.Values()) != 2 {
+			t.Errorf("expected the receiver to keep 2 values, got %d", len(f.Values()))
+		}
+	})
+}
@@ -52,7 +52,7 @@ func (m Matrix[T]) IsIdentityMatrix() bool {
 	return true
 }
 
-func (m *Matrix[T]) Zero() *Matrix[T] {
+func (m *Matrix[T]) Zero() (*Matrix[T], error) {
 	var zero T
 	return m.Fill(zero)
 }
@@ -60,13 +60,17 @@ func (m *Matrix[T]) Zero() *Matrix[T] {
 /*
 Fill a matrix with a given value element
 */
-func (m *Matrix[T]) Fill(v T) *Matrix[T] {
+func (m *Matrix[T]) Fill(v T) (*Matrix[T], error) {
+	if err := m.writable(); err != nil {
+		return nil, err
+	}
+
 	for i := uint(0); i < m.rows; i++ {
 		for j := uint(0); j < m.columns; j++ {
-			m.writer.Write(i, j, v)
+			m.write(i, j, v)
 		}
 	}
-	return m
+	return m, nil
 }
 
 func (m Matrix[T]) Clone() (*Matrix[T], error) {
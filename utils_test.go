@@ -59,7 +59,7 @@ func TestClone(t *testing.T) {
 
 		new, _ := matrix.Clone()
 
-		matrixesAreEqual(t, matrix, new)
+		matrixesAreEquivalent(t, matrix, new)
 
 		// We want to compare the address of the structs and ensure they are different
 		if pointersAreSame(&matrix, &new) {
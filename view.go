@@ -0,0 +1,121 @@
+package matrix
+
+/*
+A data store exposing a block of another matrix without copying. Reads and writes are
+offset into the parent so writes through the view are visible in the parent and keep
+the parent's index in sync. An index built on the view itself is not updated by writes
+made through the parent, call Reindex on the view after modifying the parent.
+
+A view becomes stale once its parent's data store is replaced, for example by DeleteRow
+or UnmarshalJSON, since its offsets no longer refer to the same elements. Mutating
+methods on a stale view return ErrStaleView and reading from its store panics with it.
+*/
+type ViewStore[T Element] struct {
+	parent       *Matrix[T]
+	generation   uint
+	rowOffset    uint
+	columnOffset uint
+	rows         uint
+	columns      uint
+}
+
+// Read a value from a position in the view
+func (v *ViewStore[T]) Read(i, j uint) T {
+	if v.stale() {
+		panic(ErrStaleView)
+	}
+	return v.parent.reader.Read(v.rowOffset+i, v.columnOffset+j)
+}
+
+// Get the dimensions of the view
+func (v *ViewStore[T]) Shape() (uint, uint) {
+	return v.rows, v.columns
+}
+
+// Ensure that the view lies inside the parent matrix
+func (v *ViewStore[T]) Validate() error {
+	if v.rows == 0 || v.columns == 0 {
+		return ErrRowColumSize
+	}
+
+	if v.stale() {
+		return ErrStaleView
+	}
+
+	if v.rowOffset+v.rows > v.parent.rows || v.columnOffset+v.columns > v.parent.columns {
+		return ErrMatrixOutOfBounds
+	}
+
+	return nil
+}
+
+// Write a value to a position in the view through the parent matrix
+func (v *ViewStore[T]) Write(i, j uint, value T) {
+	if v.stale() {
+		panic(ErrStaleView)
+	}
+	v.parent.write(v.rowOffset+i, v.columnOffset+j, value)
+}
+
+// Report whether the parent, or any matrix it is a view of, was reshaped since the view was made
+func (v *ViewStore[T]) stale() bool {
+	return v.generation != v.parent.generation || isStaleView(v.parent)
+}
+
+// Report whether the matrix is a view whose parent was reshaped
+func isStaleView[T Element](m *Matrix[T]) bool {
+	v, ok := m.reader.(*ViewStore[T])
+	return ok && v.stale()
+}
+
+/*
+Return a view of rows r0 up to but not including r1 and columns c0 up to but not
+including c1. The view shares data with the receiver matrix and is read only if the
+receiver is.
+*/
+func (m *Matrix[T]) Slice(r0, r1, c0, c1 uint) (*Matrix[T], error) {
+	if r1 > m.rows || c1 > m.columns {
+		return nil, ErrMatrixOutOfBounds
+	}
+
+	if r0 >= r1 || c0 >= c1 {
+		return nil, ErrRowColumSize
+	}
+
+	store := &ViewStore[T]{
+		parent:       m,
+		generation:   m.generation,
+		rowOffset:    r0,
+		columnOffset: c0,
+		rows:         r1 - r0,
+		columns:      c1 - c0,
+	}
+
+	view, err := NewMatrix[T](store)
+	if err != nil {
+		return nil, err
+	}
+
+	// A view can only be written to if the parent can
+	if m.IsReadOnly() {
+		view.writer = nil
+	}
+
+	return view, nil
+}
+
+// Return a 1 x columns view of row i
+func (m *Matrix[T]) Row(i uint) (*Matrix[T], error) {
+	if i >= m.rows {
+		return nil, ErrMatrixOutOfBounds
+	}
+	return m.Slice(i, i+1, 0, m.columns)
+}
+
+// Return a rows x 1 view of column j
+func (m *Matrix[T]) Column(j uint) (*Matrix[T], error) {
+	if j >= m.columns {
+		return nil, ErrMatrixOutOfBounds
+	}
+	return m.Slice(0, m.rows, j, j+1)
+}
@@ -0,0 +1,152 @@
+package matrix
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestSlice(t *testing.T) {
+	input := [][]int{
+		{1, 2, 3, 4},
+		{5, 6, 7, 8},
+		{9, 10, 11, 12},
+	}
+
+	t.Run("it returns a view of a block of the matrix", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice(input)
+
+		view, err := m.Slice(1, 3, 1, 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := NewMatrixFromSlice([][]int{
+			{6, 7},
+			{10, 11},
+		})
+
+		matrixesAreEquivalent(t, view, want)
+	})
+
+	t.Run("writes through the view are visible in the parent", func(t *testing.T) {
+		m, _ := NewEmptyMatrix[int](3, 3)
+		view, _ := m.Slice(1, 3, 0, 2)
+
+		view.Set(1, 1, 42)
+		view.Fill(7)
+
+		want, _ := NewMatrixFromSlice([][]int{
+			{0, 0, 0},
+			{7, 7, 0},
+			{7, 7, 0},
+		})
+
+		matrixesAreEquivalent(t, m, want)
+	})
+
+	t.Run("writes through the view keep the parent index in sync", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice(input)
+		m.Index()
+
+		view, _ := m.Slice(0, 2, 0, 2)
+		view.ScalarMultiplyInPlace(10)
+
+		assertIndexMatchesScan(t, m)
+	})
+
+	t.Run("existing operations work on views", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice(input)
+		view, _ := m.Slice(0, 2, 2, 4)
+
+		sum, _ := view.Add(view)
+		want, _ := NewMatrixFromSlice([][]int{{6, 8}, {14, 16}})
+		matrixesAreEquivalent(t, sum, want)
+
+		product, _ := view.Multiply(view)
+		want, _ = NewMatrixFromSlice([][]int{{37, 44}, {77, 92}})
+		matrixesAreEquivalent(t, product, want)
+
+		transposed, _ := view.Transpose()
+		want, _ = NewMatrixFromSlice([][]int{{3, 7}, {4, 8}})
+		matrixesAreEquivalent(t, transposed, want)
+
+		found, _ := view.Search(8)
+		if len(found) != 1 || found[0].position != [2]uint{1, 1} {
+			t.Errorf("expected 8 at view position [1 1], got %+v", found)
+		}
+	})
+
+	t.Run("it returns an error for an out of bounds range", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice(input)
+
+		_, err := m.Slice(0, 4, 0, 1)
+		if !errors.Is(err, ErrMatrixOutOfBounds) {
+			t.Errorf("expected ErrMatrixOutOfBounds, got %v", err)
+		}
+	})
+
+	t.Run("it returns an error for an empty range", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice(input)
+
+		_, err := m.Slice(2, 2, 0, 1)
+		if !errors.Is(err, ErrRowColumSize) {
+			t.Errorf("expected ErrRowColumSize, got %v", err)
+		}
+	})
+}
+
+func TestRow(t *testing.T) {
+	t.Run("it returns a view of a row", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice([][]int{
+			{1, 2},
+			{3, 4},
+		})
+
+		row, _ := m.Row(1)
+		if !reflect.DeepEqual(row.Flatten(), []int{3, 4}) {
+			t.Errorf("expected [3 4], got %v", row.Flatten())
+		}
+
+		row.Set(0, 0, 9)
+		if m.reader.Read(1, 0) != 9 {
+			t.Error("expected write through row view to be visible in the parent")
+		}
+	})
+
+	t.Run("it returns an error for an out of bounds row", func(t *testing.T) {
+		m, _ := NewEmptyMatrix[int](2, 2)
+
+		_, err := m.Row(2)
+		if !errors.Is(err, ErrMatrixOutOfBounds) {
+			t.Errorf("expected ErrMatrixOutOfBounds, got %v", err)
+		}
+	})
+}
+
+func TestColumn(t *testing.T) {
+	t.Run("it returns a view of a column", func(t *testing.T) {
+		m, _ := NewMatrixFromSlice([][]int{
+			{1, 2},
+			{3, 4},
+		})
+
+		column, _ := m.Column(1)
+		if column.Rows() != 2 || column.Columns() != 1 {
+			t.Errorf("expected a 2x1 view, got %dx%d", column.Rows(), column.Columns())
+		}
+
+		if !reflect.DeepEqual(column.Flatten(), []int{2, 4}) {
+			t.Errorf("expected [2 4], got %v", column.Flatten())
+		}
+	})
+
+	t.Run("it returns an error for an out of bounds column", func(t *testing.T) {
+		m, _ := NewEmptyMatrix[int](2, 2)
+
+		_, err := m.Column(5)
+		if !errors.Is(err, ErrMatrixOutOfBounds) {
+			t.Errorf("expected ErrMatrixOutOfBounds, got %v", err)
+		}
+	})
+}